@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// envPrefix is the prefix of the environment variables that flags can be set from.
+const envPrefix = "VOCAB_TUISTER_"
+
+// envName returns the name of the environment variable for a flag, e.g. VOCAB_TUISTER_SERVER_HOST for --server-host.
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// bindEnv sets each flag of cmd that was not given on the command line from its environment variable, if set, so that
+// flags always take precedence over the environment.
+func bindEnv(cmd *cobra.Command) error {
+	var err error
+
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if err != nil || f.Changed || f.Name == "help" || f.Name == "version" {
+			return
+		}
+
+		name := envName(f.Name)
+
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return
+		}
+
+		if setErr := cmd.Flags().Set(f.Name, value); setErr != nil {
+			err = fmt.Errorf("invalid value %q for %s: %w", value, name, setErr)
+		}
+	})
+
+	return err
+}
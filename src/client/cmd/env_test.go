@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEnvTestCommand returns a command with a few flags of different types, run with the given arguments. The values
+// of the flags once the environment has been bound are returned through the pointers.
+func newEnvTestCommand(args ...string) (cmd *cobra.Command, host *string, port *int, debug *bool) {
+	cmd = &cobra.Command{
+		Use:               "test",
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error { return bindEnv(cmd) },
+		RunE:              func(*cobra.Command, []string) error { return nil },
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+	}
+
+	host = cmd.Flags().String("server-host", "localhost", "")
+	port = cmd.Flags().Int("port", 5500, "")
+	debug = cmd.Flags().Bool("debug", false, "")
+
+	cmd.SetArgs(args)
+
+	return cmd, host, port, debug
+}
+
+func TestEnvName(t *testing.T) {
+	assert.Equal(t, "VOCAB_TUISTER_SERVER_HOST", envName("server-host"))
+	assert.Equal(t, "VOCAB_TUISTER_PORT", envName("port"))
+}
+
+func TestBindEnvOnly(t *testing.T) {
+	t.Setenv("VOCAB_TUISTER_SERVER_HOST", "192.168.1.20")
+	t.Setenv("VOCAB_TUISTER_PORT", "6000")
+	t.Setenv("VOCAB_TUISTER_DEBUG", "true")
+
+	cmd, host, port, debug := newEnvTestCommand()
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, "192.168.1.20", *host)
+	assert.Equal(t, 6000, *port)
+	assert.True(t, *debug)
+}
+
+func TestBindEnvUnset(t *testing.T) {
+	cmd, host, port, debug := newEnvTestCommand()
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, "localhost", *host)
+	assert.Equal(t, 5500, *port)
+	assert.False(t, *debug)
+}
+
+func TestBindEnvFlagOverridesEnv(t *testing.T) {
+	t.Setenv("VOCAB_TUISTER_SERVER_HOST", "192.168.1.20")
+	t.Setenv("VOCAB_TUISTER_PORT", "6000")
+
+	cmd, host, port, _ := newEnvTestCommand("--port", "7000")
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, 7000, *port)
+	assert.Equal(t, "192.168.1.20", *host, "flags not given should still be set from the environment")
+}
+
+func TestBindEnvInvalid(t *testing.T) {
+	tests := map[string]struct {
+		env, value, wantErr string
+		args                []string
+	}{
+		"Int": {
+			env:     "VOCAB_TUISTER_PORT",
+			value:   "lots",
+			wantErr: `invalid value "lots" for VOCAB_TUISTER_PORT`,
+		},
+		"Bool": {
+			env:     "VOCAB_TUISTER_DEBUG",
+			value:   "sometimes",
+			wantErr: `invalid value "sometimes" for VOCAB_TUISTER_DEBUG`,
+		},
+		"OverriddenByFlag": {
+			env:   "VOCAB_TUISTER_PORT",
+			value: "lots",
+			args:  []string{"--port", "7000"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(tt.env, tt.value)
+
+			cmd, _, _, _ := newEnvTestCommand(tt.args...)
+
+			err := cmd.Execute()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
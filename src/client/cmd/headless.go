@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create/config"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+)
+
+// readSessionConfig reads and validates the session config file at filePath.
+func readSessionConfig(filePath string) (*pb.SessionConfig, int, questions.MarkingOptions, error) {
+	b, err := config.ReadSessionConfigFile(filePath)
+	if err != nil {
+		return nil, 0, questions.MarkingOptions{}, fmt.Errorf(
+			"failed to read session config from %s: %w",
+			filePath,
+			err,
+		)
+	}
+
+	sessionConfig, numberOfQuestions, marking, err := create.ParseSessionConfig(b)
+	if err != nil {
+		return nil, 0, questions.MarkingOptions{}, fmt.Errorf("%s is not a valid session config: %w", filePath, err)
+	}
+
+	if errs := create.ValidateSessionConfig(sessionConfig, numberOfQuestions); len(errs) > 0 {
+		return nil, 0, questions.MarkingOptions{}, fmt.Errorf(
+			"%s is not a valid session config: %w",
+			filePath,
+			errors.Join(errs...),
+		)
+	}
+
+	return sessionConfig, numberOfQuestions, marking, nil
+}
+
+// runHeadless runs a session without the TUI. The questions are written to stdout as JSON, or if --answers is given,
+// the answers are marked and the results are written instead.
+func runHeadless(cmd *cobra.Command, server app.ServerOptions) error {
+	var (
+		qs      questions.Questions
+		marking questions.MarkingOptions
+	)
+
+	if fromPath != "" {
+		sessionConfig, numberOfQuestions, m, err := readSessionConfig(fromPath)
+		if err != nil {
+			return err
+		}
+
+		marking = m
+
+		if questionsPath == "" {
+			vocabList, err := os.ReadFile(listPath)
+			if err != nil {
+				return fmt.Errorf("failed to read vocab list from %s: %w", listPath, err)
+			}
+
+			qs, err = session.FetchQuestions(
+				server,
+				string(vocabList),
+				sessionConfig,
+				numberOfQuestions,
+				allowFewer,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if questionsPath != "" {
+		var err error
+		if qs, err = session.LoadQuestionsFile(questionsPath); err != nil {
+			return err
+		}
+	}
+
+	// --seed is required to mark answers to randomly ordered questions, so they are shuffled as they were written out
+	s := seed
+	if s == 0 {
+		s = uint64(time.Now().UnixNano())
+	}
+
+	qs.Reorder(questionOrder, rand.New(rand.NewPCG(s, s)))
+
+	var (
+		output []byte
+		err    error
+	)
+
+	if answersPath == "" {
+		output, err = session.MarshalQuestions(qs)
+		if err != nil {
+			return err
+		}
+	} else {
+		var responses []byte
+		if answersPath == "-" {
+			responses, err = io.ReadAll(cmd.InOrStdin())
+		} else {
+			responses, err = os.ReadFile(answersPath)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read answers from %s: %w", answersPath, err)
+		}
+
+		results, err := session.Grade(qs, responses, marking)
+		if err != nil {
+			return err
+		}
+
+		if output, err = json.Marshal(results, jsontext.WithIndent("  ")); err != nil {
+			return fmt.Errorf("failed to marshal session results: %w", err)
+		}
+	}
+
+	_, err = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", output)
+
+	return err
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json/v2"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/stats"
+)
+
+var translations = map[string]string{
+	"puer":     "boy",
+	"puella":   "girl",
+	"servus":   "slave",
+	"dominus":  "master",
+	"agricola": "farmer",
+	"nauta":    "sailor",
+}
+
+// runHeadlessOutput runs a headless session on the test questions, returning what it writes to stdout.
+func runHeadlessOutput(t *testing.T) []byte {
+	t.Helper()
+
+	var out bytes.Buffer
+
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+
+	require.NoError(t, runHeadless(cmd, app.ServerOptions{}))
+
+	return out.Bytes()
+}
+
+func TestRunHeadlessRandomOrder(t *testing.T) {
+	setFlag(t, &questionsPath, filepath.Join("testdata", "questions.json"))
+	setFlag(t, &questionOrder, questions.OrderRandom)
+	setFlag(t, &seed, 7)
+	setFlag(t, &answersPath, "")
+
+	var written []map[string]map[string]any
+	require.NoError(t, json.Unmarshal(runHeadlessOutput(t), &written))
+
+	// answer the questions in the order they were written out
+	answers := make([]string, len(written))
+	for i, q := range written {
+		answers[i] = translations[q["typeInLatToEng"]["prompt"].(string)]
+	}
+
+	data, err := json.Marshal(answers)
+	require.NoError(t, err)
+
+	answersFile := filepath.Join(t.TempDir(), "answers.json")
+	require.NoError(t, os.WriteFile(answersFile, data, 0o600))
+	setFlag(t, &answersPath, answersFile)
+
+	var results stats.Results
+	require.NoError(t, json.Unmarshal(runHeadlessOutput(t), &results))
+
+	assert.Equal(t, len(translations), results.Answered)
+	assert.Equal(t, len(translations), results.Correct, "answers were marked against different questions")
+}
+
+func TestHeadlessRandomOrderRequiresSeed(t *testing.T) {
+	setFlag(t, &serverHost, "localhost")
+	setFlag(t, &serverScheme, "http")
+	setFlag(t, &attempts, 1)
+	setFlag(t, &jsonOutput, true)
+	setFlag(t, &questionsPath, filepath.Join("testdata", "questions.json"))
+	setFlag(t, &answersPath, "answers.json")
+
+	tests := map[string]struct {
+		seed    uint64
+		order   questions.Order
+		wantErr bool
+	}{
+		"RandomWithoutSeed": {order: questions.OrderRandom, wantErr: true},
+		"RandomWithSeed":    {order: questions.OrderRandom, seed: 7},
+		"ServerWithoutSeed": {order: questions.OrderServer},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			setFlag(t, &orderName, tt.order.String())
+			setFlag(t, &seed, tt.seed)
+
+			err := rootCmd.PreRunE(rootCmd, nil)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "--answers with --order random requires")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
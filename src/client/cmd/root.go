@@ -28,14 +28,44 @@ import (
 
 	"github.com/rduo1009/vocab-tuister/src/assets/inbuiltlists"
 	"github.com/rduo1009/vocab-tuister/src/client/internal"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create/config"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/root"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questioncomponents"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
 )
 
 var (
-	serverPort int
-	noServer   bool
-	debugMode  bool
+	serverHost     string
+	serverScheme   string
+	serverInsecure bool
+	serverPort     int
+	noServer       bool
+	serverBinary   string
+	debugMode      bool
+	resultsPath    string
+	attempts       int
+	presetName     string
+	fromPath       string
+	listPath       string
+	tidyList       bool
+	shuffleChoices bool
+	seed           uint64
+	offline        bool
+	questionsPath  string
+	historyPath    string
+	markedPath     string
+	keymapPath     string
+	revealAfter    int
+	noBell         bool
+	orderName      string
+	questionOrder  questions.Order
+	allowFewer     bool
+	jsonOutput     bool
+	answersPath    string
 )
 
 // getServerBinaryNames returns a list of possible server binary names based on the current platform and architecture.
@@ -75,13 +105,22 @@ func getServerBinaryNames() []string {
 
 // startServer starts the vocab-tuister server in the background.
 // If debug is true, it uses 'python3 -m src'.
-// Otherwise, it looks for the pre-built binary in the executable's directory or on the PATH.
-func startServer(ctx context.Context, debug bool, port int, stdout, stderr io.Writer) (*exec.Cmd, error) {
+// Otherwise, it runs binaryPath if given, or else looks for the pre-built binary in the executable's directory or on
+// the PATH.
+func startServer(
+	ctx context.Context,
+	debug bool,
+	binaryPath string,
+	port int,
+	stdout, stderr io.Writer,
+) (*exec.Cmd, error) {
 	var cmd *exec.Cmd
 
 	if debug {
 		// In debug mode, run the server using the python module
 		cmd = exec.CommandContext(ctx, "python3", "-m", "src", "-p", strconv.Itoa(port))
+	} else if binaryPath != "" {
+		cmd = exec.CommandContext(ctx, binaryPath, "-p", strconv.Itoa(port))
 	} else {
 		// In production mode, look for the compiled binary
 		binaryNames := getServerBinaryNames()
@@ -138,6 +177,18 @@ func isPortInUse(ctx context.Context, port int) bool {
 	return false
 }
 
+// startsLocalServer reports whether the bundled server should be started alongside the TUI. It is only started for a
+// plaintext server on this machine, as it does not serve over TLS, and not if the questions are read from a file
+// instead.
+func startsLocalServer(server app.ServerOptions) bool {
+	if noServer || offline || !server.Local() || server.UseTLS {
+		return false
+	}
+
+	// questions read from a file don't need the server, even if --offline isn't given
+	return !jsonOutput || questionsPath == ""
+}
+
 func extractEmbeddedFS(efs embed.FS, target string) error {
 	return fs.WalkDir(efs, ".", func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -191,9 +242,102 @@ var rootCmd = &cobra.Command{
 	Short:        "Latin vocabulary and grammar testing.",
 	SilenceUsage: true,
 	Long: `Vocab-tuister is a tool for improving your Latin vocabulary and endings.
-The project homepage is at https://github.com/rduo1009/vocab-tuister.`,
+The project homepage is at https://github.com/rduo1009/vocab-tuister.
+
+Any flag can also be set from an environment variable named after it, e.g. VOCAB_TUISTER_SERVER_HOST for
+--server-host. Flags given on the command line take precedence.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return bindEnv(cmd)
+	},
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if strings.TrimSpace(serverHost) == "" {
+			return errors.New("server host must not be empty")
+		}
+
+		if serverScheme != "http" && serverScheme != "https" {
+			return fmt.Errorf("invalid server scheme %q: must be http or https", serverScheme)
+		}
+
+		if serverInsecure && serverScheme != "https" {
+			return errors.New("--insecure can only be used with --server-scheme https")
+		}
+
+		if presetName != "" {
+			if _, err := os.Stat(config.PresetPath(presetName)); err != nil {
+				return fmt.Errorf("failed to find preset %q: %w", presetName, err)
+			}
+		}
+
+		if attempts < 1 {
+			return fmt.Errorf("invalid number of attempts %d: must be at least 1", attempts)
+		}
+
+		if revealAfter < 0 {
+			return fmt.Errorf("invalid --reveal-after %d: must not be negative", revealAfter)
+		}
+
+		order, err := questions.ParseOrder(orderName)
+		if err != nil {
+			return fmt.Errorf("invalid --order: %w", err)
+		}
+
+		questionOrder = order
+
+		if presetName != "" && fromPath != "" {
+			return errors.New("--preset and --from cannot be used together")
+		}
+
+		if serverBinary != "" && noServer {
+			return errors.New("--server-cmd cannot be used with --no-server")
+		}
+
+		if serverBinary != "" && !(app.ServerOptions{Host: serverHost}).Local() {
+			return fmt.Errorf("--server-cmd cannot be used with a server on another host (%s)", serverHost)
+		}
+
+		if serverBinary != "" && serverScheme == "https" {
+			return errors.New("--server-cmd cannot be used with --server-scheme https")
+		}
+
+		if offline && questionsPath == "" {
+			return errors.New("--offline requires --questions-file")
+		}
+
+		if jsonOutput && questionsPath == "" && (listPath == "" || fromPath == "") {
+			return errors.New("--json requires --questions-file, or both --list and --from")
+		}
+
+		if answersPath != "" && !jsonOutput {
+			return errors.New("--answers can only be used with --json")
+		}
+
+		// without a seed, the questions would be shuffled differently to when they were written out
+		if answersPath != "" && questionOrder == questions.OrderRandom && seed == 0 {
+			return errors.New("--answers with --order random requires the --seed that the questions were written with")
+		}
+
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if !noServer {
+		// read the keymap first, so that a bad keymap is reported without waiting for the server to start
+		var keyOverrides questioncomponents.KeyOverrides
+		if keymapPath != "" {
+			var err error
+
+			keyOverrides, err = session.LoadKeyOverrides(keymapPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		server := app.ServerOptions{
+			Host:               serverHost,
+			Port:               serverPort,
+			UseTLS:             serverScheme == "https",
+			InsecureSkipVerify: serverInsecure,
+		}
+
+		if startsLocalServer(server) {
 			ctx := cmd.Context()
 			if isPortInUse(ctx, serverPort) {
 				return fmt.Errorf("port %d is already in use; the server cannot start", serverPort)
@@ -205,7 +349,7 @@ The project homepage is at https://github.com/rduo1009/vocab-tuister.`,
 				errBuf bytes.Buffer
 			)
 
-			serverCmd, err := startServer(ctx, debugMode, serverPort, &outBuf, &errBuf)
+			serverCmd, err := startServer(ctx, debugMode, serverBinary, serverPort, &outBuf, &errBuf)
 			if err != nil {
 				return fmt.Errorf("failed to start server: %w", err)
 			}
@@ -225,53 +369,57 @@ The project homepage is at https://github.com/rduo1009/vocab-tuister.`,
 
 			var startupErr error
 
-			spinErr := spinner.New().
-				Title(fmt.Sprintf("Server starting on PID %d and port %d...", serverCmd.Process.Pid, serverPort)).
-				Action(func() {
-					ticker := time.NewTicker(200 * time.Millisecond)
-					defer ticker.Stop()
+			waitForServer := func() {
+				ticker := time.NewTicker(200 * time.Millisecond)
+				defer ticker.Stop()
 
-					timeout := time.After(15 * time.Second)
+				timeout := time.After(15 * time.Second)
 
-					for {
-						select {
-						case err := <-errCh:
-							outStr := strings.TrimSpace(outBuf.String())
-							errStr := strings.TrimSpace(errBuf.String())
+				for {
+					select {
+					case err := <-errCh:
+						outStr := strings.TrimSpace(outBuf.String())
+						errStr := strings.TrimSpace(errBuf.String())
 
-							var msg string
-							if err != nil {
-								msg = fmt.Sprintf("server exited unexpectedly: %v", err)
-							} else {
-								msg = "server exited unexpectedly (status 0)"
-							}
+						var msg string
+						if err != nil {
+							msg = fmt.Sprintf("server exited unexpectedly: %v", err)
+						} else {
+							msg = "server exited unexpectedly (status 0)"
+						}
 
-							if outStr != "" {
-								msg += "\nstdout:\n" + outStr
-							}
+						if outStr != "" {
+							msg += "\nstdout:\n" + outStr
+						}
 
-							if errStr != "" {
-								msg += "\nstderr:\n" + errStr
-							}
+						if errStr != "" {
+							msg += "\nstderr:\n" + errStr
+						}
 
-							startupErr = fmt.Errorf("%s", msg)
+						startupErr = fmt.Errorf("%s", msg)
 
-							return
+						return
 
-						case <-timeout:
-							startupErr = errors.New("timed out waiting for server to start")
-							return
+					case <-timeout:
+						startupErr = errors.New("timed out waiting for server to start")
+						return
 
-						case <-ticker.C:
-							if checkGRPCHealth(serverPort) {
-								return
-							}
+					case <-ticker.C:
+						if checkGRPCHealth(serverPort) {
+							return
 						}
 					}
-				}).
-				Run()
-			if spinErr != nil {
-				return fmt.Errorf("spinner error: %w", spinErr)
+				}
+			}
+
+			// the spinner is left out when writing JSON, so that only the JSON is written to stdout
+			if jsonOutput {
+				waitForServer()
+			} else if err := spinner.New().
+				Title(fmt.Sprintf("Server starting on PID %d and port %d...", serverCmd.Process.Pid, serverPort)).
+				Action(waitForServer).
+				Run(); err != nil {
+				return fmt.Errorf("spinner error: %w", err)
 			}
 
 			if startupErr != nil {
@@ -279,6 +427,10 @@ The project homepage is at https://github.com/rduo1009/vocab-tuister.`,
 			}
 		}
 
+		if jsonOutput {
+			return runHeadless(cmd, server)
+		}
+
 		// XXX: https://github.com/charmbracelet/bubbles/pull/954 would remove need for this
 		inbuiltListTmpDir, err := os.MkdirTemp("", "inbuilt-lists")
 		if err != nil {
@@ -290,26 +442,182 @@ The project homepage is at https://github.com/rduo1009/vocab-tuister.`,
 			return err
 		}
 
-		p := tea.NewProgram(root.New(inbuiltListTmpDir, serverPort))
-		if _, err := p.Run(); err != nil {
+		var presetPath string
+		if presetName != "" {
+			presetPath = config.PresetPath(presetName)
+		}
+
+		p := tea.NewProgram(root.New(
+			inbuiltListTmpDir,
+			create.Options{
+				ListPath:   listPath,
+				TidyList:   tidyList,
+				PresetPath: presetPath,
+				FromPath:   fromPath,
+			},
+			server,
+			session.Options{
+				ResultsPath:    resultsPath,
+				Attempts:       attempts,
+				ShuffleChoices: shuffleChoices,
+				Seed:           seed,
+				QuestionsPath:  questionsPath,
+				HistoryPath:    historyPath,
+				MarkedPath:     markedPath,
+				KeyOverrides:   keyOverrides,
+				RevealAfter:    time.Duration(revealAfter) * time.Second,
+				Bell:           !noBell,
+				Order:          questionOrder,
+				AllowFewer:     allowFewer,
+			},
+		))
+
+		finalModel, err := p.Run()
+		if err != nil {
 			return err
 		}
 
+		if summary := finalModel.(*root.Model).SessionSummary(); summary != "" {
+			fmt.Println(summary)
+		}
+
 		return nil
 	},
 }
 
 func Execute() {
+	rootCmd.PersistentFlags().StringVar(
+		&serverHost,
+		"server-host",
+		"localhost",
+		"host of the server to connect to (the bundled server is only started if it is this machine)",
+	)
+	rootCmd.PersistentFlags().StringVar(
+		&serverScheme,
+		"server-scheme",
+		"http",
+		"scheme used to connect to the server (http or https, the bundled server is not started for https)",
+	)
+	rootCmd.PersistentFlags().BoolVar(
+		&serverInsecure,
+		"insecure",
+		false,
+		"skip verifying the server certificate when using https",
+	)
 	rootCmd.PersistentFlags().IntVarP(&serverPort, "port", "p", 5500, "port to run server on")
 	rootCmd.PersistentFlags().BoolVar(&noServer, "no-server", false, "do not start server - TUI only")
+	rootCmd.PersistentFlags().StringVar(
+		&serverBinary,
+		"server-cmd",
+		"",
+		"path to the server binary to start, instead of looking for the bundled one",
+	)
 	rootCmd.PersistentFlags().BoolVar(&debugMode, "debug", false, "enable debug mode")
+	rootCmd.Flags().StringVar(&presetName, "preset", "", "name of a saved session config preset to load on startup")
+	rootCmd.Flags().StringVar(
+		&listPath,
+		"list",
+		"",
+		"vocab list file to open for editing (created when saved if it does not exist)",
+	)
+	rootCmd.Flags().BoolVar(
+		&tidyList,
+		"tidy",
+		false,
+		"normalise spacing and align entries when saving the vocab list",
+	)
+	rootCmd.Flags().StringVar(
+		&fromPath,
+		"from",
+		"",
+		"session config file to pre-populate the config form with",
+	)
+	rootCmd.Flags().StringVar(&resultsPath, "results", "", "file to save session results to as JSON")
+	rootCmd.Flags().IntVar(
+		&attempts,
+		"attempts",
+		1,
+		"number of attempts allowed at type-in and principal parts questions",
+	)
+	rootCmd.Flags().IntVar(
+		&revealAfter,
+		"reveal-after",
+		0,
+		"seconds after which an unanswered question is marked incorrect and its answer shown (0 to disable)",
+	)
+	rootCmd.Flags().BoolVar(&noBell, "no-bell", false, "do not ring the bell or flash when an answer is incorrect")
+	rootCmd.Flags().StringVar(
+		&orderName,
+		"order",
+		questions.OrderServer.String(),
+		"order to ask the questions in (server, random or by-type)",
+	)
+	rootCmd.Flags().BoolVar(
+		&allowFewer,
+		"allow-fewer",
+		false,
+		"ask fewer questions if the vocab list cannot provide as many as requested, instead of stopping with an error",
+	)
+	rootCmd.Flags().BoolVar(
+		&shuffleChoices,
+		"shuffle-choices",
+		false,
+		"shuffle the options of multiple choice questions",
+	)
+	rootCmd.Flags().Uint64Var(&seed, "seed", 0, "seed to shuffle options and questions with (0 for a random seed)")
+	rootCmd.Flags().BoolVar(&offline, "offline", false, "practise without the server, using --questions-file")
+	rootCmd.Flags().StringVar(
+		&questionsPath,
+		"questions-file",
+		"",
+		"file of questions (as a JSON array) to use instead of requesting them from the server",
+	)
+	rootCmd.Flags().BoolVar(
+		&jsonOutput,
+		"json",
+		false,
+		"write the questions to stdout as JSON instead of starting the TUI (or the results, with --answers)",
+	)
+	rootCmd.Flags().StringVar(
+		&answersPath,
+		"answers",
+		"",
+		"JSON array of answers to mark with --json, one per question (null to skip), or - to read from stdin",
+	)
+	rootCmd.Flags().StringVar(
+		&historyPath,
+		"history",
+		"",
+		"results file of a previous session (from --results), whose missed words are asked first and again at the end",
+	)
+	rootCmd.Flags().StringVar(
+		&markedPath,
+		"marked-out",
+		"",
+		"file to save the prompts of questions marked for later (with ctrl+r) to, one per line",
+	)
+	rootCmd.Flags().StringVar(
+		&keymapPath,
+		"keymap",
+		"",
+		"JSON file mapping session actions (e.g. Submit, Skip, Up, Down) to the keys to use for them",
+	)
+
+	for _, name := range []string{
+		"list", "from", "results", "questions-file", "history", "marked-out", "keymap", "answers",
+	} {
+		_ = rootCmd.MarkFlagFilename(name)
+	}
+
+	_ = rootCmd.MarkPersistentFlagFilename("server-cmd")
+
+	rootCmd.AddCommand(validateListCmd, validateConfigCmd, statsCmd, schemaCmd)
 
 	isDark := lipgloss.HasDarkBackground(os.Stdin, os.Stderr)
 	if err := fang.Execute(
 		context.Background(),
 		rootCmd,
 		fang.WithVersion(internal.Version),
-		fang.WithoutCompletions(),
 		fang.WithColorSchemeFunc(styles.DefaultStyles(styles.DefaultThemes(isDark).Current(), false).Fang),
 	); err != nil {
 		os.Exit(1)
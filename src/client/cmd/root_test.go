@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+)
+
+// setFlag sets a flag variable for the duration of a test.
+func setFlag[T any](t *testing.T, p *T, value T) {
+	t.Helper()
+
+	old := *p
+	*p = value
+
+	t.Cleanup(func() { *p = old })
+}
+
+func TestStartsLocalServer(t *testing.T) {
+	tests := map[string]struct {
+		server   app.ServerOptions
+		noServer bool
+		offline  bool
+		want     bool
+	}{
+		"Localhost":     {server: app.ServerOptions{Host: "localhost"}, want: true},
+		"Loopback":      {server: app.ServerOptions{Host: "127.0.0.1"}, want: true},
+		"RemoteHost":    {server: app.ServerOptions{Host: "192.168.1.20"}, want: false},
+		"RemoteName":    {server: app.ServerOptions{Host: "vocab.example.com"}, want: false},
+		"NoServer":      {server: app.ServerOptions{Host: "localhost"}, noServer: true, want: false},
+		"Offline":       {server: app.ServerOptions{Host: "localhost"}, offline: true, want: false},
+		"RemoteOffline": {server: app.ServerOptions{Host: "192.168.1.20"}, offline: true, want: false},
+		"TLS":           {server: app.ServerOptions{Host: "localhost", UseTLS: true}, want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			setFlag(t, &noServer, tt.noServer)
+			setFlag(t, &offline, tt.offline)
+
+			assert.Equal(t, tt.want, startsLocalServer(tt.server))
+		})
+	}
+}
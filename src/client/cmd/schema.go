@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON schema of the questions sent by the server.",
+	Long: `Print a description of each kind of question sent by the server, as JSON. A question is an object with
+exactly one of the listed keys, whose value has the listed fields. This is also the format of the questions in a
+--questions-file.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		data, err := json.Marshal(questions.Schema(), jsontext.WithIndent("  "))
+		if err != nil {
+			return fmt.Errorf("failed to marshal question schema: %w", err)
+		}
+
+		fmt.Fprintln(cmd.OutOrStdout(), string(data))
+
+		return nil
+	},
+}
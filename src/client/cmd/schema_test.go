@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"encoding/json/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema(t *testing.T) {
+	stdout, _, err := executeCommand(t, schemaCmd)
+	require.NoError(t, err)
+
+	var schema []struct {
+		Key     string           `json:"key"`
+		Message string           `json:"message"`
+		Fields  []map[string]any `json:"fields"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &schema), "output is not valid JSON")
+
+	messages := make(map[string]string, len(schema))
+	for _, variant := range schema {
+		messages[variant.Key] = variant.Message
+		assert.NotEmptyf(t, variant.Fields, "%s has no fields", variant.Key)
+	}
+
+	assert.Equal(t, map[string]string{
+		"mcEngToLat":     "MultipleChoiceEngToLatQuestion",
+		"mcLatToEng":     "MultipleChoiceLatToEngQuestion",
+		"parseCompToLat": "ParseWordCompToLatQuestion",
+		"parseLatToComp": "ParseWordLatToCompQuestion",
+		"principalParts": "PrincipalPartsQuestion",
+		"typeInEngToLat": "TypeInEngToLatQuestion",
+		"typeInLatToEng": "TypeInLatToEngQuestion",
+	}, messages)
+}
+
+func TestSchemaArgs(t *testing.T) {
+	stdout, _, err := executeCommand(t, schemaCmd, "extra")
+	assert.ErrorContains(t, err, `unknown command "extra" for "vocab-tuister schema"`)
+	assert.Empty(t, stdout)
+}
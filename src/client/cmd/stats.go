@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/stats"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats [file or directory...]",
+	Short: "Summarise the results of past sessions.",
+	Long: `Summarise the results files saved with --results, giving the overall accuracy, the average time taken and
+the accuracy for each type of question. Directories are searched for results files. Files that can't be read are
+skipped with a warning.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		summary, errs := stats.Summarise(args)
+		for _, err := range errs {
+			fmt.Fprintf(cmd.ErrOrStderr(), "warning: %v\n", err)
+		}
+
+		if summary.Sessions == 0 {
+			return errors.New("no session results found")
+		}
+
+		out := cmd.OutOrStdout()
+		fmt.Fprintf(out, "Sessions: %d\n", summary.Sessions)
+		fmt.Fprintf(
+			out,
+			"Accuracy: %d/%d (%.1f%%)\n",
+			summary.Correct,
+			summary.Answered,
+			summary.Accuracy(),
+		)
+		fmt.Fprintf(out, "Average duration: %s\n", summary.AverageDuration().Round(time.Second))
+
+		modes := slices.Sorted(maps.Keys(summary.ByMode))
+		if len(modes) > 0 {
+			fmt.Fprintln(out, "By question type:")
+		}
+
+		for _, mode := range modes {
+			score := summary.ByMode[mode]
+			fmt.Fprintf(
+				out,
+				"  %s: %d/%d (%.1f%%)\n",
+				mode,
+				score.Correct,
+				score.Answered,
+				100*float64(score.Correct)/float64(score.Answered),
+			)
+		}
+
+		return nil
+	},
+}
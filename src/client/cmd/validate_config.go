@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create/config"
+)
+
+var validateConfigCmd = &cobra.Command{
+	Use:   "validate-config [file]",
+	Short: "Check a session config file without starting the TUI.",
+	Long: `Check that a session config file can be used to create a session. Each problem is reported, and the command
+exits with a non-zero status if any are found.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filePath := args[0]
+
+		b, err := config.ReadSessionConfigFile(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read session config from %s: %w", filePath, err)
+		}
+
+		sessionConfig, numberOfQuestions, _, err := create.ParseSessionConfig(b)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid session config: %w", filePath, err)
+		}
+
+		if errs := create.ValidateSessionConfig(sessionConfig, numberOfQuestions); len(errs) > 0 {
+			for _, err := range errs {
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s: %v\n", filePath, err)
+			}
+
+			return fmt.Errorf("%s is not a valid session config", filePath)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "OK: %d questions\n", numberOfQuestions)
+
+		return nil
+	},
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// executeCommand runs cmd as a subcommand with the given arguments, returning what it writes to stdout and stderr. Its
+// parent is silenced as the root command is, so that usage is not written on errors.
+func executeCommand(t *testing.T, cmd *cobra.Command, args ...string) (stdout, stderr string, err error) {
+	t.Helper()
+
+	var outBuf, errBuf bytes.Buffer
+
+	root := &cobra.Command{Use: "vocab-tuister", SilenceUsage: true}
+	root.AddCommand(cmd)
+	root.SetOut(&outBuf)
+	root.SetErr(&errBuf)
+	root.SetArgs(append([]string{cmd.Name()}, args...))
+
+	t.Cleanup(func() { root.RemoveCommand(cmd) })
+
+	err = root.Execute()
+
+	return outBuf.String(), errBuf.String(), err
+}
+
+func TestValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		file       string
+		wantOut    string
+		wantErr    string
+		wantStderr []string
+	}{
+		"Valid":     {file: "valid.json", wantOut: "OK: 25 questions\n"},
+		"ValidYAML": {file: "valid.yaml", wantOut: "OK: 10 questions\n"},
+		"Invalid": {
+			file:    "invalid.json",
+			wantErr: "is not a valid session config",
+			wantStderr: []string{
+				"number-of-questions must be positive, got 0",
+				"number-multiplechoice-options must be between 2 and 10, got 1",
+				"no question types are included",
+			},
+		},
+		"Malformed": {file: "malformed.json", wantErr: "is not a valid session config: failed to unmarshal"},
+		"Missing":   {file: "missing.json", wantErr: "failed to read session config from"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdout, stderr, err := executeCommand(t, validateConfigCmd, filepath.Join("testdata", "configs", tt.file))
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantOut, stdout)
+
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Empty(t, stdout)
+
+			for _, s := range tt.wantStderr {
+				assert.Contains(t, stderr, s)
+			}
+		})
+	}
+}
+
+func TestValidateConfigArgs(t *testing.T) {
+	_, _, err := executeCommand(t, validateConfigCmd)
+	assert.ErrorContains(t, err, "accepts 1 arg(s), received 0")
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create/list"
+)
+
+var validateListCmd = &cobra.Command{
+	Use:   "validate-list [file]",
+	Short: "Check a vocab list file without starting the TUI.",
+	Long: `Check that a vocab list file is in the format expected by the server, using the same checks as the list
+editor. Each malformed line and duplicate entry is reported, and the command exits with a non-zero status if any
+are found.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filePath := args[0]
+
+		b, err := os.ReadFile(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read vocab list from %s: %w", filePath, err)
+		}
+
+		vocabList := string(b)
+
+		errs := append(list.ValidateVocabList(vocabList), list.DuplicateEntries(vocabList)...)
+		if len(errs) > 0 {
+			for _, err := range errs {
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s: %v\n", filePath, err)
+			}
+
+			return fmt.Errorf("%s is not a valid vocab list", filePath)
+		}
+
+		total, _ := list.CountEntries(strings.Split(vocabList, "\n"))
+		fmt.Fprintf(cmd.OutOrStdout(), "OK: %d entries\n", total)
+
+		return nil
+	},
+}
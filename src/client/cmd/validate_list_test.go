@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateList(t *testing.T) {
+	tests := map[string]struct {
+		file       string
+		wantOut    string
+		wantErr    string
+		wantStderr []string
+	}{
+		"Valid": {file: "valid.txt", wantOut: "OK: 4 entries\n"},
+		"Invalid": {
+			file:    "invalid.txt",
+			wantErr: "is not a valid vocab list",
+			wantStderr: []string{
+				`invalid.txt: line 3: expected a line of the form "meaning: latin, ..."`,
+				`invalid.txt: line 4: duplicate entry "boy" (first given on line 2)`,
+			},
+		},
+		"Missing": {file: "missing.txt", wantErr: "failed to read vocab list from"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stdout, stderr, err := executeCommand(t, validateListCmd, filepath.Join("testdata", "lists", tt.file))
+
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantOut, stdout)
+
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Empty(t, stdout)
+
+			for _, s := range tt.wantStderr {
+				assert.Contains(t, stderr, s)
+			}
+		})
+	}
+}
+
+func TestValidateListArgs(t *testing.T) {
+	_, _, err := executeCommand(t, validateListCmd, "a.txt", "b.txt")
+	assert.ErrorContains(t, err, "accepts 1 arg(s), received 2")
+}
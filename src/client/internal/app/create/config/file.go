@@ -0,0 +1,51 @@
+package config
+
+import (
+	"encoding/json/v2"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/util/flatyaml"
+)
+
+// fileTypes are the extensions of the files that session configs can be loaded from and saved to.
+var fileTypes = []string{".json", ".yaml", ".yml"}
+
+// isYAML reports whether a session config file is in YAML rather than JSON, going by its extension.
+func isYAML(filePath string) bool {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// ReadSessionConfigFile reads a session config file, returning it as JSON. Files with a .yaml or .yml extension are
+// read as YAML, and any other file as JSON.
+func ReadSessionConfigFile(filePath string) ([]byte, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil || !isYAML(filePath) {
+		return data, err
+	}
+
+	values, err := flatyaml.Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse yaml: %w", err)
+	}
+
+	return json.Marshal(values)
+}
+
+// encodeSessionConfig returns a session config (as JSON) in the format to save it to filePath in, which is YAML if
+// the file has a .yaml or .yml extension, and JSON otherwise.
+func encodeSessionConfig(filePath, rawSessionConfig string) ([]byte, error) {
+	if !isYAML(filePath) {
+		return []byte(rawSessionConfig), nil
+	}
+
+	var values configMap
+	if err := json.Unmarshal([]byte(rawSessionConfig), &values); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal session config: %w", err)
+	}
+
+	return flatyaml.Marshal(values)
+}
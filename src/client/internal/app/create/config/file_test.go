@@ -0,0 +1,82 @@
+package config
+
+import (
+	"encoding/json/v2"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionConfigRoundTrip(t *testing.T) {
+	values := defaultFormValues()
+	values.set("exclude-nouns", true)
+	values.set("include-parse", false)
+	values.NumberMultipleChoiceOptionsString = "4"
+
+	msg := generateSessionConfig(values)()
+	require.IsType(t, rawSessionConfigMsg{}, msg)
+
+	raw := msg.(rawSessionConfigMsg)
+
+	var want map[string]any
+	require.NoError(t, json.Unmarshal(raw, &want))
+
+	for _, name := range []string{"config.json", "config.yaml", "config.yml"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			assert.Nil(t, saveSessionConfig(path, string(raw))())
+
+			data, err := ReadSessionConfigFile(path)
+			require.NoError(t, err)
+
+			var got map[string]any
+			require.NoError(t, json.Unmarshal(data, &got))
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestSessionConfigSavedAsYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.Nil(t, saveSessionConfig(path, `{"exclude-nouns": true, "number-of-questions": 20}`)())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// saved as YAML rather than JSON
+	assert.Contains(t, string(data), "exclude-nouns: true")
+	assert.Contains(t, string(data), "number-of-questions: 20")
+	assert.NotContains(t, string(data), "{")
+}
+
+func TestPresetPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+	t.Setenv("APPDATA", home)
+	require.NoError(t, os.MkdirAll(PresetDir(), 0o755))
+
+	for _, name := range []string{"a.json", "b.yaml", "c.yml"} {
+		require.NoError(t, os.WriteFile(filepath.Join(PresetDir(), name), nil, 0o644))
+	}
+
+	testCases := []struct {
+		name   string
+		preset string
+		want   string
+	}{
+		{name: "JSON", preset: "a", want: "a.json"},
+		{name: "YAML", preset: "b", want: "b.yaml"},
+		{name: "YML", preset: "c", want: "c.yml"},
+		{name: "Missing", preset: "d", want: "d.json"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, filepath.Join(PresetDir(), tc.want), PresetPath(tc.preset))
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"encoding/json/v2"
 	"errors"
+	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"charm.land/huh/v2"
 
@@ -28,6 +32,13 @@ type formValues struct {
 	NumberOfQuestionsString           string
 }
 
+// The range of the number of options allowed in multiple choice questions. Fewer than two options would leave nothing
+// to choose between, and more than ten would not fit on most terminals.
+const (
+	MinMultipleChoiceOptions = 2
+	MaxMultipleChoiceOptions = 10
+)
+
 var allKeys = []string{
 	"english-subjunctives",
 	"english-verbal-nouns",
@@ -145,214 +156,386 @@ var allKeys = []string{
 	"include-principal-parts",
 	"include-typein-engtolat",
 	"include-typein-lattoeng",
+	"lenient-articles",
+	"progressive-reveal",
+	"require-macrons",
 }
 
-func defaultForm() (*huh.Form, *formValues) {
-	// Default values
-	values := &formValues{
+func defaultFormValues() *formValues {
+	return &formValues{
+		QuestionTypes: []string{
+			"include-typein-engtolat",
+			"include-typein-lattoeng",
+			"include-parse",
+			"include-inflect",
+			"include-principal-parts",
+			"include-multiplechoice-engtolat",
+			"include-multiplechoice-lattoeng",
+		},
 		NumberMultipleChoiceOptionsString: "3",
 		NumberOfQuestionsString:           "50",
 	}
+}
+
+// fieldFor returns the form value that the given session config key is selected in, or nil if the key is not
+// part of the form.
+func (values *formValues) fieldFor(key string) *[]string {
+	switch {
+	case key == "exclude-verbs", key == "exclude-participles", key == "exclude-nouns", key == "exclude-adjectives",
+		key == "exclude-adverbs", key == "exclude-pronouns", key == "exclude-regulars":
+		return &values.PartsOfSpeechExclusions
+
+	case key == "exclude-deponents", key == "exclude-semi-deponents", strings.HasPrefix(key, "exclude-verb-"):
+		return &values.VerbExclusions
+
+	case strings.HasPrefix(key, "exclude-participle-"):
+		return &values.ParticipleExclusions
+
+	case key == "exclude-gerundives", key == "exclude-gerunds", key == "exclude-supines":
+		return &values.OtherVerbExclusions
+
+	case strings.HasPrefix(key, "exclude-noun-"):
+		return &values.NounExclusions
+
+	case strings.HasPrefix(key, "exclude-adjective-"):
+		return &values.AdjectiveExclusions
+
+	case strings.HasPrefix(key, "exclude-adverb-"):
+		return &values.AdverbExclusions
+
+	case strings.HasPrefix(key, "exclude-pronoun-"):
+		return &values.PronounExclusions
+
+	case strings.HasPrefix(key, "english-"), key == "require-macrons", key == "lenient-articles",
+		key == "progressive-reveal":
+		return &values.Miscellaneous
+
+	case strings.HasPrefix(key, "include-"):
+		return &values.QuestionTypes
+
+	default:
+		return nil
+	}
+}
+
+// set selects or unselects the given session config key in the form. Keys that are not part of the form are ignored.
+func (values *formValues) set(key string, setting bool) {
+	field := values.fieldFor(key)
+	if field == nil {
+		return
+	}
+
+	*field = slices.DeleteFunc(*field, func(k string) bool { return k == key })
+	if setting {
+		*field = append(*field, key)
+	}
+}
+
+// formValuesFromSessionConfig returns the form values that would generate the given session config.
+// Unknown keys are ignored, and missing keys are left as their default values.
+func formValuesFromSessionConfig(rawSessionConfig []byte) (*formValues, error) {
+	var sessionConfig map[string]any
+	if err := json.Unmarshal(rawSessionConfig, &sessionConfig); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal session config: %w", err)
+	}
+
+	values := defaultFormValues()
+
+	for _, key := range allKeys {
+		setting, ok := sessionConfig[key].(bool)
+		if !ok {
+			continue
+		}
+
+		values.set(key, setting)
+	}
+
+	if x, ok := sessionConfig["number-multiplechoice-options"].(float64); ok {
+		values.NumberMultipleChoiceOptionsString = strconv.Itoa(int(x))
+	}
+
+	if x, ok := sessionConfig["number-of-questions"].(float64); ok {
+		values.NumberOfQuestionsString = strconv.Itoa(int(x))
+	}
+
+	return values, nil
+}
+
+// summary lists the titles of the options selected on each page of the form, so that they can be reviewed before
+// finishing. labels maps each session config key to its title. Pages with nothing selected are left out.
+func (values *formValues) summary(labels map[string]string) string {
+	pages := []struct {
+		title    string
+		selected []string
+	}{
+		{"Parts of speech exclusions", values.PartsOfSpeechExclusions},
+		{"Verb exclusions", values.VerbExclusions},
+		{"Participle exclusions", values.ParticipleExclusions},
+		{"Other verb exclusions", values.OtherVerbExclusions},
+		{"Noun exclusions", values.NounExclusions},
+		{"Adjective exclusions", values.AdjectiveExclusions},
+		{"Adverb exclusions", values.AdverbExclusions},
+		{"Pronoun exclusions", values.PronounExclusions},
+		{"Miscellaneous", values.Miscellaneous},
+		{"Question types", values.QuestionTypes},
+	}
+
+	var lines []string
+
+	for _, page := range pages {
+		if len(page.selected) == 0 {
+			continue
+		}
+
+		lines = append(lines, page.title)
+		for _, key := range page.selected {
+			lines = append(lines, "• "+labels[key])
+		}
+	}
+
+	if len(lines) == 0 {
+		return "Nothing is selected."
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// validateMultipleChoiceOptions checks that str is a number of options allowed in multiple choice questions.
+func validateMultipleChoiceOptions(str string) error {
+	x, err := strconv.Atoi(str)
+	if err != nil {
+		return errors.New("must be an integer")
+	}
+
+	if x < MinMultipleChoiceOptions || x > MaxMultipleChoiceOptions {
+		return fmt.Errorf("must be between %d and %d", MinMultipleChoiceOptions, MaxMultipleChoiceOptions)
+	}
+
+	return nil
+}
+
+func defaultForm() (*huh.Form, formPages, *formValues) {
+	values := defaultFormValues()
+	form, pages := newForm(values)
+
+	return form, pages, values
+}
+
+// newForm creates the session config form, with the options in values already selected, along with the page that
+// each of its fields is on.
+func newForm(values *formValues) (*huh.Form, formPages) {
+	// the titles of the options by their session config keys, so that the summary can list them by title
+	labels := map[string]string{}
+	option := func(title, key string) huh.Option[string] {
+		labels[key] = title
+		return huh.NewOption(title, key)
+	}
+
+	pages := formPages{}
+	group := func(fields ...huh.Field) *huh.Group {
+		page := pages.total()
+		for _, field := range fields {
+			pages[field] = page
+		}
+
+		return huh.NewGroup(fields...)
+	}
 
 	form := huh.NewForm(
-		huh.NewGroup(
+		group(
 			huh.NewMultiSelect[string]().
 				Title("Parts of speech exclusions").
 				Options(
-					huh.NewOption("Exclude verbs", "exclude-verbs"),
-					huh.NewOption("Exclude participles", "exclude-participles"),
-					huh.NewOption("Exclude nouns", "exclude-nouns"),
-					huh.NewOption("Exclude adjectives", "exclude-adjectives"),
-					huh.NewOption("Exclude adverbs", "exclude-adverbs"),
-					huh.NewOption("Exclude pronouns", "exclude-pronouns"),
-					huh.NewOption("Exclude regular words", "exclude-regulars"),
+					option("Exclude verbs", "exclude-verbs"),
+					option("Exclude participles", "exclude-participles"),
+					option("Exclude nouns", "exclude-nouns"),
+					option("Exclude adjectives", "exclude-adjectives"),
+					option("Exclude adverbs", "exclude-adverbs"),
+					option("Exclude pronouns", "exclude-pronouns"),
+					option("Exclude regular words", "exclude-regulars"),
 				).
 				Value(&values.PartsOfSpeechExclusions),
 		),
-		huh.NewGroup(
+		group(
 			huh.NewMultiSelect[string]().
 				Title("Verb exclusions").
 				Options(
-					huh.NewOption("Deponent verbs", "exclude-deponents"),
-					huh.NewOption("Semi-deponent verbs", "exclude-semi-deponents"),
-					huh.NewOption("First conjugation verbs", "exclude-verb-first-conjugation"),
-					huh.NewOption("Second conjugation verbs", "exclude-verb-second-conjugation"),
-					huh.NewOption("Third conjugation verbs", "exclude-verb-third-conjugation"),
-					huh.NewOption("Fourth conjugation verbs", "exclude-verb-fourth-conjugation"),
-					huh.NewOption("Mixed conjugation verbs", "exclude-verb-mixed-conjugation"),
-					huh.NewOption("Irregular verbs", "exclude-verb-irregular-conjugation"),
-					huh.NewOption("Present active indicative", "exclude-verb-present-active-indicative"),
-					huh.NewOption("Imperfect active indicative", "exclude-verb-imperfect-active-indicative"),
-					huh.NewOption("Future active indicative", "exclude-verb-future-active-indicative"),
-					huh.NewOption("Perfect active indicative", "exclude-verb-perfect-active-indicative"),
-					huh.NewOption("Pluperfect active indicative", "exclude-verb-pluperfect-active-indicative"),
-					huh.NewOption("Future perfect active indicative", "exclude-verb-future-perfect-active-indicative"),
-					huh.NewOption("Present passive indicative", "exclude-verb-present-passive-indicative"),
-					huh.NewOption("Imperfect passive indicative", "exclude-verb-imperfect-passive-indicative"),
-					huh.NewOption("Future passive indicative", "exclude-verb-future-passive-indicative"),
-					huh.NewOption("Perfect passive indicative", "exclude-verb-perfect-passive-indicative"),
-					huh.NewOption("Pluperfect passive indicative", "exclude-verb-pluperfect-passive-indicative"),
-					huh.NewOption("Future perfect passive indicative", "exclude-verb-future-perfect-passive-indicative"),
-					huh.NewOption("Present active subjunctive", "exclude-verb-present-active-subjunctive"),
-					huh.NewOption("Imperfect active subjunctive", "exclude-verb-imperfect-active-subjunctive"),
-					huh.NewOption("Perfect active subjunctive", "exclude-verb-perfect-active-subjunctive"),
-					huh.NewOption("Pluperfect active subjunctive", "exclude-verb-pluperfect-active-subjunctive"),
-					huh.NewOption("Present active imperative", "exclude-verb-present-active-imperative"),
-					huh.NewOption("Future active imperative", "exclude-verb-future-active-imperative"),
-					huh.NewOption("Present passive imperative", "exclude-verb-present-passive-imperative"),
-					huh.NewOption("Future passive imperative", "exclude-verb-future-passive-imperative"),
-					huh.NewOption("Present active infinitive", "exclude-verb-present-active-infinitive"),
-					huh.NewOption("Future active infinitive", "exclude-verb-future-active-infinitive"),
-					huh.NewOption("Perfect active infinitive", "exclude-verb-perfect-active-infinitive"),
-					huh.NewOption("Present passive infinitive", "exclude-verb-present-passive-infinitive"),
-					huh.NewOption("Future passive infinitive", "exclude-verb-future-passive-infinitive"),
-					huh.NewOption("Perfect passive infinitive", "exclude-verb-perfect-passive-infinitive"),
-					huh.NewOption("Singular number", "exclude-verb-singular"),
-					huh.NewOption("Plural number", "exclude-verb-plural"),
-					huh.NewOption("1st person", "exclude-verb-first-person"),
-					huh.NewOption("2nd person", "exclude-verb-second-person"),
-					huh.NewOption("3rd person", "exclude-verb-third-person"),
+					option("Deponent verbs", "exclude-deponents"),
+					option("Semi-deponent verbs", "exclude-semi-deponents"),
+					option("First conjugation verbs", "exclude-verb-first-conjugation"),
+					option("Second conjugation verbs", "exclude-verb-second-conjugation"),
+					option("Third conjugation verbs", "exclude-verb-third-conjugation"),
+					option("Fourth conjugation verbs", "exclude-verb-fourth-conjugation"),
+					option("Mixed conjugation verbs", "exclude-verb-mixed-conjugation"),
+					option("Irregular verbs", "exclude-verb-irregular-conjugation"),
+					option("Present active indicative", "exclude-verb-present-active-indicative"),
+					option("Imperfect active indicative", "exclude-verb-imperfect-active-indicative"),
+					option("Future active indicative", "exclude-verb-future-active-indicative"),
+					option("Perfect active indicative", "exclude-verb-perfect-active-indicative"),
+					option("Pluperfect active indicative", "exclude-verb-pluperfect-active-indicative"),
+					option("Future perfect active indicative", "exclude-verb-future-perfect-active-indicative"),
+					option("Present passive indicative", "exclude-verb-present-passive-indicative"),
+					option("Imperfect passive indicative", "exclude-verb-imperfect-passive-indicative"),
+					option("Future passive indicative", "exclude-verb-future-passive-indicative"),
+					option("Perfect passive indicative", "exclude-verb-perfect-passive-indicative"),
+					option("Pluperfect passive indicative", "exclude-verb-pluperfect-passive-indicative"),
+					option("Future perfect passive indicative", "exclude-verb-future-perfect-passive-indicative"),
+					option("Present active subjunctive", "exclude-verb-present-active-subjunctive"),
+					option("Imperfect active subjunctive", "exclude-verb-imperfect-active-subjunctive"),
+					option("Perfect active subjunctive", "exclude-verb-perfect-active-subjunctive"),
+					option("Pluperfect active subjunctive", "exclude-verb-pluperfect-active-subjunctive"),
+					option("Present active imperative", "exclude-verb-present-active-imperative"),
+					option("Future active imperative", "exclude-verb-future-active-imperative"),
+					option("Present passive imperative", "exclude-verb-present-passive-imperative"),
+					option("Future passive imperative", "exclude-verb-future-passive-imperative"),
+					option("Present active infinitive", "exclude-verb-present-active-infinitive"),
+					option("Future active infinitive", "exclude-verb-future-active-infinitive"),
+					option("Perfect active infinitive", "exclude-verb-perfect-active-infinitive"),
+					option("Present passive infinitive", "exclude-verb-present-passive-infinitive"),
+					option("Future passive infinitive", "exclude-verb-future-passive-infinitive"),
+					option("Perfect passive infinitive", "exclude-verb-perfect-passive-infinitive"),
+					option("Singular number", "exclude-verb-singular"),
+					option("Plural number", "exclude-verb-plural"),
+					option("1st person", "exclude-verb-first-person"),
+					option("2nd person", "exclude-verb-second-person"),
+					option("3rd person", "exclude-verb-third-person"),
 				).
 				Value(&values.VerbExclusions),
 			huh.NewMultiSelect[string]().
 				Title("Participle exclusions").
 				Options(
-					huh.NewOption("Present active", "exclude-participle-present-active"),
-					huh.NewOption("Perfect passive", "exclude-participle-perfect-passive"),
-					huh.NewOption("Future active", "exclude-participle-future-active"),
-					huh.NewOption("Masculine gender", "exclude-participle-masculine"),
-					huh.NewOption("Feminine gender", "exclude-participle-feminine"),
-					huh.NewOption("Neuter gender", "exclude-participle-neuter"),
-					huh.NewOption("Nominative case", "exclude-participle-nominative"),
-					huh.NewOption("Vocative case", "exclude-participle-vocative"),
-					huh.NewOption("Accusative case", "exclude-participle-accusative"),
-					huh.NewOption("Genitive case", "exclude-participle-genitive"),
-					huh.NewOption("Dative case", "exclude-participle-dative"),
-					huh.NewOption("Ablative case", "exclude-participle-ablative"),
-					huh.NewOption("Singular number", "exclude-participle-singular"),
-					huh.NewOption("Plural number", "exclude-participle-plural"),
+					option("Present active", "exclude-participle-present-active"),
+					option("Perfect passive", "exclude-participle-perfect-passive"),
+					option("Future active", "exclude-participle-future-active"),
+					option("Masculine gender", "exclude-participle-masculine"),
+					option("Feminine gender", "exclude-participle-feminine"),
+					option("Neuter gender", "exclude-participle-neuter"),
+					option("Nominative case", "exclude-participle-nominative"),
+					option("Vocative case", "exclude-participle-vocative"),
+					option("Accusative case", "exclude-participle-accusative"),
+					option("Genitive case", "exclude-participle-genitive"),
+					option("Dative case", "exclude-participle-dative"),
+					option("Ablative case", "exclude-participle-ablative"),
+					option("Singular number", "exclude-participle-singular"),
+					option("Plural number", "exclude-participle-plural"),
 				).
 				Value(&values.ParticipleExclusions),
 			huh.NewMultiSelect[string]().
 				Title("Other verb exclusions").
 				Options(
-					huh.NewOption("Gerundives", "exclude-gerundives"),
-					huh.NewOption("Gerunds", "exclude-gerunds"),
-					huh.NewOption("Supines", "exclude-supines"),
+					option("Gerundives", "exclude-gerundives"),
+					option("Gerunds", "exclude-gerunds"),
+					option("Supines", "exclude-supines"),
 				).
 				Value(&values.OtherVerbExclusions),
 		),
-		huh.NewGroup(
+		group(
 			huh.NewMultiSelect[string]().
 				Title("Noun exclusions").
 				Options(
-					huh.NewOption("First declension nouns", "exclude-noun-first-declension"),
-					huh.NewOption("Second declension nouns", "exclude-noun-second-declension"),
-					huh.NewOption("Third declension nouns", "exclude-noun-third-declension"),
-					huh.NewOption("Fourth declension nouns", "exclude-noun-fourth-declension"),
-					huh.NewOption("Fifth declension nouns", "exclude-noun-fifth-declension"),
-					huh.NewOption("Irregular nouns", "exclude-noun-irregular-declension"),
-					huh.NewOption("Nominative case", "exclude-noun-nominative"),
-					huh.NewOption("Vocative case", "exclude-noun-vocative"),
-					huh.NewOption("Accusative case", "exclude-noun-accusative"),
-					huh.NewOption("Genitive case", "exclude-noun-genitive"),
-					huh.NewOption("Dative case", "exclude-noun-dative"),
-					huh.NewOption("Ablative case", "exclude-noun-ablative"),
-					huh.NewOption("Singular number", "exclude-noun-singular"),
-					huh.NewOption("Plural number", "exclude-noun-plural"),
+					option("First declension nouns", "exclude-noun-first-declension"),
+					option("Second declension nouns", "exclude-noun-second-declension"),
+					option("Third declension nouns", "exclude-noun-third-declension"),
+					option("Fourth declension nouns", "exclude-noun-fourth-declension"),
+					option("Fifth declension nouns", "exclude-noun-fifth-declension"),
+					option("Irregular nouns", "exclude-noun-irregular-declension"),
+					option("Nominative case", "exclude-noun-nominative"),
+					option("Vocative case", "exclude-noun-vocative"),
+					option("Accusative case", "exclude-noun-accusative"),
+					option("Genitive case", "exclude-noun-genitive"),
+					option("Dative case", "exclude-noun-dative"),
+					option("Ablative case", "exclude-noun-ablative"),
+					option("Singular number", "exclude-noun-singular"),
+					option("Plural number", "exclude-noun-plural"),
 				).
 				Value(&values.NounExclusions),
 		),
-		huh.NewGroup(
+		group(
 			huh.NewMultiSelect[string]().
 				Title("Adjective exclusions").
 				Options(
-					huh.NewOption("First and second declension adjectives", "exclude-adjective-212-declension"),
-					huh.NewOption("Third declension adjectives", "exclude-adjective-third-declension"),
-					huh.NewOption("Masculine gender", "exclude-adjective-masculine"),
-					huh.NewOption("Feminine gender", "exclude-adjective-feminine"),
-					huh.NewOption("Neuter gender", "exclude-adjective-neuter"),
-					huh.NewOption("Nominative case", "exclude-adjective-nominative"),
-					huh.NewOption("Vocative case", "exclude-adjective-vocative"),
-					huh.NewOption("Accusative case", "exclude-adjective-accusative"),
-					huh.NewOption("Genitive case", "exclude-adjective-genitive"),
-					huh.NewOption("Dative case", "exclude-adjective-dative"),
-					huh.NewOption("Ablative case", "exclude-adjective-ablative"),
-					huh.NewOption("Singular number", "exclude-adjective-singular"),
-					huh.NewOption("Plural number", "exclude-adjective-plural"),
-					huh.NewOption("Positive degree", "exclude-adjective-positive"),
-					huh.NewOption("Comparative degree", "exclude-adjective-comparative"),
-					huh.NewOption("Superlative degree", "exclude-adjective-superlative"),
+					option("First and second declension adjectives", "exclude-adjective-212-declension"),
+					option("Third declension adjectives", "exclude-adjective-third-declension"),
+					option("Masculine gender", "exclude-adjective-masculine"),
+					option("Feminine gender", "exclude-adjective-feminine"),
+					option("Neuter gender", "exclude-adjective-neuter"),
+					option("Nominative case", "exclude-adjective-nominative"),
+					option("Vocative case", "exclude-adjective-vocative"),
+					option("Accusative case", "exclude-adjective-accusative"),
+					option("Genitive case", "exclude-adjective-genitive"),
+					option("Dative case", "exclude-adjective-dative"),
+					option("Ablative case", "exclude-adjective-ablative"),
+					option("Singular number", "exclude-adjective-singular"),
+					option("Plural number", "exclude-adjective-plural"),
+					option("Positive degree", "exclude-adjective-positive"),
+					option("Comparative degree", "exclude-adjective-comparative"),
+					option("Superlative degree", "exclude-adjective-superlative"),
 				).
 				Value(&values.AdjectiveExclusions),
 			huh.NewMultiSelect[string]().
 				Title("Adverb exclusions").
 				Options(
-					huh.NewOption("Positive degree", "exclude-adverb-positive"),
-					huh.NewOption("Comparative degree", "exclude-adverb-comparative"),
-					huh.NewOption("Superlative degree", "exclude-adverb-superlative"),
+					option("Positive degree", "exclude-adverb-positive"),
+					option("Comparative degree", "exclude-adverb-comparative"),
+					option("Superlative degree", "exclude-adverb-superlative"),
 				).
 				Value(&values.AdverbExclusions),
 		),
-		huh.NewGroup(
+		group(
 			huh.NewMultiSelect[string]().
 				Title("Pronoun exclusions").
 				Options(
-					huh.NewOption("Masculine gender", "exclude-pronoun-masculine"),
-					huh.NewOption("Feminine gender", "exclude-pronoun-feminine"),
-					huh.NewOption("Neuter gender", "exclude-pronoun-neuter"),
-					huh.NewOption("Nominative case", "exclude-pronoun-nominative"),
-					huh.NewOption("Vocative case", "exclude-pronoun-vocative"),
-					huh.NewOption("Accusative case", "exclude-pronoun-accusative"),
-					huh.NewOption("Genitive case", "exclude-pronoun-genitive"),
-					huh.NewOption("Dative case", "exclude-pronoun-dative"),
-					huh.NewOption("Ablative case", "exclude-pronoun-ablative"),
-					huh.NewOption("Singular number", "exclude-pronoun-singular"),
-					huh.NewOption("Plural number", "exclude-pronoun-plural"),
+					option("Masculine gender", "exclude-pronoun-masculine"),
+					option("Feminine gender", "exclude-pronoun-feminine"),
+					option("Neuter gender", "exclude-pronoun-neuter"),
+					option("Nominative case", "exclude-pronoun-nominative"),
+					option("Vocative case", "exclude-pronoun-vocative"),
+					option("Accusative case", "exclude-pronoun-accusative"),
+					option("Genitive case", "exclude-pronoun-genitive"),
+					option("Dative case", "exclude-pronoun-dative"),
+					option("Ablative case", "exclude-pronoun-ablative"),
+					option("Singular number", "exclude-pronoun-singular"),
+					option("Plural number", "exclude-pronoun-plural"),
 				).
 				Value(&values.PronounExclusions),
 		),
-		huh.NewGroup(
+		group(
 			huh.NewMultiSelect[string]().
 				Title("Miscellaneous").
 				Options(
-					huh.NewOption("English translations of subjunctive verbs", "english-subjunctives"),
-					huh.NewOption("English translations of verbal nouns (gerunds/supines)", "english-verbal-nouns"),
+					option("English translations of subjunctive verbs", "english-subjunctives"),
+					option("English translations of verbal nouns (gerunds/supines)", "english-verbal-nouns"),
+					option("Require macrons in answers", "require-macrons"),
+					option("Accept English answers without a leading article or \"I\"", "lenient-articles"),
+					option("Reveal a letter of the answer after each wrong guess", "progressive-reveal"),
 				).
 				Value(&values.Miscellaneous),
 		),
-		huh.NewGroup(
+		group(
 			huh.NewMultiSelect[string]().
 				Title("Question types").
 				Options(
-					huh.NewOption("Type-in English to Latin", "include-typein-engtolat").
-						Selected(true),
-					huh.NewOption("Type-in Latin to English", "include-typein-lattoeng").
-						Selected(true),
-					huh.NewOption("Parsing", "include-parse").Selected(true),
-					huh.NewOption("Inflecting", "include-inflect").Selected(true),
-					huh.NewOption("Principal parts", "include-principal-parts").Selected(true),
-					huh.NewOption("Multiple choice English to Latin", "include-multiplechoice-engtolat").
-						Selected(true),
-					huh.NewOption("Multiple choice Latin to English", "include-multiplechoice-lattoeng").
-						Selected(true),
+					option("Type-in English to Latin", "include-typein-engtolat"),
+					option("Type-in Latin to English", "include-typein-lattoeng"),
+					option("Parsing", "include-parse"),
+					option("Inflecting", "include-inflect"),
+					option("Principal parts", "include-principal-parts"),
+					option("Multiple choice English to Latin", "include-multiplechoice-engtolat"),
+					option("Multiple choice Latin to English", "include-multiplechoice-lattoeng"),
 				).
 				Value(&values.QuestionTypes),
+		),
+		group(
+			huh.NewNote().
+				Title("Summary").
+				DescriptionFunc(func() string { return values.summary(labels) }, values).
+				Next(true),
+		),
+		group(
 			huh.NewInput().
 				Title("Number of options in multiple choice questions").
 				Value(&values.NumberMultipleChoiceOptionsString).
-				Validate(func(str string) error {
-					x, err := strconv.Atoi(str)
-					if err != nil {
-						return errors.New("must be an integer")
-					}
-
-					if x < 2 {
-						return errors.New("must be at least 2")
-					}
-
-					return nil
-				}),
+				Validate(validateMultipleChoiceOptions),
 			huh.NewInput().
 				Title("Number of questions").
 				Value(&values.NumberOfQuestionsString).
@@ -373,5 +556,5 @@ func defaultForm() (*huh.Form, *formValues) {
 
 	form.SubmitCmd = util.MsgCmd(formSubmittedMsg{})
 
-	return form, values
+	return form, pages
 }
@@ -0,0 +1,235 @@
+package config
+
+import (
+	"encoding/json/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
+)
+
+func TestValidateMultipleChoiceOptions(t *testing.T) {
+	tests := map[string]string{
+		"0":   "must be between 2 and 10",
+		"1":   "must be between 2 and 10",
+		"999": "must be between 2 and 10",
+		"-3":  "must be between 2 and 10",
+		"two": "must be an integer",
+		"":    "must be an integer",
+		"2":   "",
+		"4":   "",
+		"10":  "",
+	}
+
+	for str, wantErr := range tests {
+		t.Run(str, func(t *testing.T) {
+			err := validateMultipleChoiceOptions(str)
+			if wantErr != "" {
+				assert.EqualError(t, err, wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReadFormValuesFile(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"config.json": `{
+			"exclude-nouns": true,
+			"exclude-verb-future-perfect-passive-indicative": true,
+			"include-parse": false,
+			"include-principal-parts": true,
+			"not-a-setting": true,
+			"number-multiplechoice-options": 5
+		}`,
+		"config.yaml": `
+exclude-nouns: true
+exclude-verb-future-perfect-passive-indicative: true
+include-parse: false
+include-principal-parts: true
+not-a-setting: true
+number-multiplechoice-options: 5
+`,
+	}
+
+	for name, contents := range files {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+			msg := readFormValuesFile(path)()
+			require.IsType(t, formValuesMsg{}, msg)
+
+			values := msg.(formValuesMsg).values
+			assert.Equal(t, []string{"exclude-nouns"}, values.PartsOfSpeechExclusions)
+			assert.Equal(t, []string{"exclude-verb-future-perfect-passive-indicative"}, values.VerbExclusions)
+			assert.Equal(t, "5", values.NumberMultipleChoiceOptionsString)
+
+			// settings that are missing keep their defaults
+			assert.ElementsMatch(t, []string{
+				"include-typein-engtolat",
+				"include-typein-lattoeng",
+				"include-inflect",
+				"include-principal-parts",
+				"include-multiplechoice-engtolat",
+				"include-multiplechoice-lattoeng",
+			}, values.QuestionTypes)
+			assert.Empty(t, values.NounExclusions)
+			assert.Equal(t, defaultFormValues().NumberOfQuestionsString, values.NumberOfQuestionsString)
+		})
+	}
+}
+
+func TestReadFormValuesFileRoundTrip(t *testing.T) {
+	values := defaultFormValues()
+	values.set("exclude-pronouns", true)
+	values.set("include-parse", false)
+	values.set("require-macrons", true)
+	values.NumberMultipleChoiceOptionsString = "6"
+
+	msg := generateSessionConfig(values)()
+	require.IsType(t, rawSessionConfigMsg{}, msg)
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, msg.(rawSessionConfigMsg), 0o600))
+
+	// a saved config loads back into the same form
+	loaded := readFormValuesFile(path)()
+	require.IsType(t, formValuesMsg{}, loaded)
+
+	got := loaded.(formValuesMsg).values
+	for _, key := range allKeys {
+		field, gotField := values.fieldFor(key), got.fieldFor(key)
+		if field == nil {
+			continue
+		}
+
+		assert.Equalf(t, slices.Contains(*field, key), slices.Contains(*gotField, key), "wrong setting for %s", key)
+	}
+
+	assert.Equal(t, "6", got.NumberMultipleChoiceOptionsString)
+	assert.Equal(t, values.NumberOfQuestionsString, got.NumberOfQuestionsString)
+}
+
+func TestReadFormValuesFileInvalid(t *testing.T) {
+	dir := t.TempDir()
+
+	corrupt := filepath.Join(dir, "corrupt.json")
+	require.NoError(t, os.WriteFile(corrupt, []byte(`{"exclude-nouns": `), 0o600))
+
+	tests := map[string]string{
+		"Missing": filepath.Join(dir, "missing.json"),
+		"Corrupt": corrupt,
+	}
+
+	for name, path := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := readFormValuesFile(path)()
+			require.Implements(t, (*app.ErrMsg)(nil), msg)
+			assert.ErrorContains(t, msg.(app.ErrMsg), "failed to read session config file at "+path)
+		})
+	}
+}
+
+func TestFromPathPopulatesForm(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+exclude-nouns: true
+include-parse: false
+not-a-setting: true
+number-multiplechoice-options: 5
+`), 0o600))
+
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	m := New("", path, &s)
+
+	// the file is read once the form starts, as if --from had been given
+	var loaded tea.Msg
+
+	batch, ok := m.Init()().(tea.BatchMsg)
+	require.True(t, ok)
+
+	for _, cmd := range batch {
+		if msg, ok := cmd().(formValuesMsg); ok {
+			loaded = msg
+		}
+	}
+
+	require.NotNil(t, loaded, "session config not read")
+	m.Update(loaded)
+	assert.Same(t, m.form, m.FormSection.form)
+
+	msg := generateSessionConfig(m.configFormValues)()
+	require.IsType(t, rawSessionConfigMsg{}, msg)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(msg.(rawSessionConfigMsg), &got))
+
+	assert.Equal(t, true, got["exclude-nouns"])
+	assert.Equal(t, false, got["include-parse"])
+	assert.Equal(t, 5.0, got["number-multiplechoice-options"])
+
+	// unknown keys are ignored, and missing keys keep their defaults
+	assert.NotContains(t, got, "not-a-setting")
+	assert.Equal(t, false, got["exclude-verbs"])
+	assert.Equal(t, true, got["include-typein-engtolat"])
+
+	// the form isn't loaded again when it restarts, so that changes made since aren't lost
+	assert.Empty(t, m.fromPath)
+}
+
+func TestSummaryPage(t *testing.T) {
+	values := defaultFormValues()
+	values.set("exclude-nouns", true)
+	values.set("exclude-verb-present-active-indicative", true)
+	values.set("include-parse", false)
+
+	m := newTestForm(t)
+	m.Update(formValuesMsg{values: values})
+
+	// the summary is worked out once the page is shown, so the messages the form sends are passed back to it
+	_, cmd := m.Update(tea.KeyPressMsg{Code: '8', Text: "8"})
+	for range 2 {
+		msgs := []tea.Msg{cmd()}
+		if batch, ok := msgs[0].(tea.BatchMsg); ok {
+			msgs = nil
+			for _, c := range batch {
+				msgs = append(msgs, c())
+			}
+		}
+
+		var cmds []tea.Cmd
+		for _, msg := range msgs {
+			_, c := m.Update(msg)
+			cmds = append(cmds, c)
+		}
+
+		cmd = tea.Batch(cmds...)
+	}
+
+	view := m.View()
+	require.Contains(t, view, "Summary")
+
+	// options are listed by their titles rather than their keys
+	for _, want := range []string{
+		"Parts of speech exclusions",
+		"• Exclude nouns",
+		"• Present active indicative",
+		"• Type-in English to Latin",
+	} {
+		assert.Contains(t, view, want)
+	}
+
+	assert.NotContains(t, view, "• Parsing")
+	assert.NotContains(t, view, "exclude-nouns")
+}
@@ -5,8 +5,20 @@ import (
 )
 
 func (m *Model) Init() tea.Cmd {
-	return tea.Batch(
+	cmds := []tea.Cmd{
 		m.form.Init(),
 		m.Filepicker.Init(),
-	)
+		m.SaveAs.Init(),
+	}
+
+	// only load the preset the first time, so that it doesn't override any changes
+	if m.presetPath != "" {
+		cmds = append(cmds, readSessionConfigFile(m.presetPath))
+		m.presetPath = ""
+	} else if m.fromPath != "" {
+		cmds = append(cmds, readFormValuesFile(m.fromPath))
+		m.fromPath = ""
+	}
+
+	return tea.Batch(cmds...)
 }
@@ -93,10 +93,37 @@ func (rb *resetButton) KeyMap() resetButtonKeyMap {
 	}
 }
 
+func (sb *savePresetButton) KeyMap() resetButtonKeyMap {
+	return resetButtonKeyMap{
+		PressButton: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "save preset"),
+		),
+		PreviousFocus: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "focus previous"),
+		),
+		NextFocus: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "focus next"),
+		),
+		Help: key.NewBinding(
+			key.WithKeys("ctrl+h"),
+			key.WithHelp("ctrl+h", "toggle additional help"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("ctrl+q", "ctrl+c"),
+			key.WithHelp("ctrl+q", "quit"),
+		),
+	}
+}
+
 type formSectionKeyMap struct {
 	fs            *formSection
 	PreviousFocus key.Binding
 	NextFocus     key.Binding
+	JumpToPage    key.Binding
+	ApplyPreset   key.Binding
 	Help          key.Binding
 	Quit          key.Binding
 }
@@ -111,12 +138,12 @@ func (k formSectionKeyMap) ShortHelp() []key.Binding {
 func (k formSectionKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.PreviousFocus, k.NextFocus},
-		k.fs.form.KeyBinds(),
+		append(k.fs.form.KeyBinds(), k.JumpToPage, k.ApplyPreset),
 		{k.Help, k.Quit},
 	}
 }
 
-func (fs *formSection) KeyMap() help.KeyMap {
+func (fs *formSection) KeyMap() formSectionKeyMap {
 	return formSectionKeyMap{
 		fs: fs,
 		PreviousFocus: key.NewBinding(
@@ -127,6 +154,14 @@ func (fs *formSection) KeyMap() help.KeyMap {
 			key.WithKeys("]"),
 			key.WithHelp("]", "focus next"),
 		),
+		JumpToPage: key.NewBinding(
+			key.WithKeys("1", "2", "3", "4", "5", "6", "7", "8", "9"),
+			key.WithHelp("1-9", "jump to page"),
+		),
+		ApplyPreset: key.NewBinding(
+			key.WithKeys("alt+1", "alt+2", "alt+3"),
+			key.WithHelp("alt+1-3", "beginner/intermediate/advanced preset"),
+		),
 		Help: key.NewBinding(
 			key.WithKeys("ctrl+h"),
 			key.WithHelp("ctrl+h", "toggle additional help"),
@@ -153,6 +188,9 @@ func (m *Model) KeyMap() help.KeyMap {
 	case m.ResetButton.Focused():
 		return m.ResetButton.KeyMap()
 
+	case m.SavePresetButton.Focused():
+		return m.SavePresetButton.KeyMap()
+
 	default:
 		panic("unreachable")
 	}
@@ -1,10 +1,14 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
+
 	"charm.land/huh/v2"
 
 	"github.com/rduo1009/vocab-tuister/src/client/internal/components/filepicker"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/components/jsonview"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/components/saveas"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/util/appdir"
 )
@@ -22,7 +26,8 @@ type (
 		focused bool
 		form    *huh.Form
 	}
-	resetButton struct{ focused bool }
+	resetButton      struct{ focused bool }
+	savePresetButton struct{ focused bool }
 )
 
 func (hs *headerSection) Focus() {
@@ -61,6 +66,18 @@ func (rb *resetButton) Focused() bool {
 	return rb.focused
 }
 
+func (sb *savePresetButton) Focus() {
+	sb.focused = true
+}
+
+func (sb *savePresetButton) Blur() {
+	sb.focused = false
+}
+
+func (sb *savePresetButton) Focused() bool {
+	return sb.focused
+}
+
 type Model struct {
 	// Layout state
 
@@ -68,43 +85,79 @@ type Model struct {
 
 	// Components
 
-	HeaderSection *headerSection
-	FormSection   *formSection
-	ResetButton   *resetButton
-	Filepicker    *filepicker.Model
-	form          *huh.Form
-	jsonview      *jsonview.Model
+	HeaderSection    *headerSection
+	FormSection      *formSection
+	ResetButton      *resetButton
+	SavePresetButton *savePresetButton
+	Filepicker       *filepicker.Model
+	SaveAs           *saveas.Model
+	form             *huh.Form
+	formPages        formPages
+	jsonview         *jsonview.Model
 
 	// Application state
 
 	styles           *styles.StylesWrapper
 	AppStatus        createSessionConfigStatus
 	FilepickerActive bool
+	SaveAsActive     bool
+	presetPath       string // preset to load on startup, if not empty
+	fromPath         string // session config to pre-populate the form with on startup, if not empty
 	configFormValues *formValues
 	RawSessionConfig string
 }
 
-const filepickerID = "configtuiFilepicker"
+const (
+	filepickerID = "configtuiFilepicker"
+	saveAsID     = "configtuiSaveAs"
+)
+
+// PresetDir returns the directory that session config presets are saved to and loaded from.
+func PresetDir() string {
+	return filepath.Join(appdir.AppDirs.UserConfig(), "sessionconfig")
+}
+
+// PresetPath returns the path of the session config preset with the given name. Presets can be saved as JSON or
+// YAML, so this is the first of name.json, name.yaml and name.yml that exists, or name.json if none of them do.
+func PresetPath(name string) string {
+	for _, ext := range fileTypes {
+		path := filepath.Join(PresetDir(), name+ext)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+
+	return filepath.Join(PresetDir(), name+".json")
+}
 
-func New(styles *styles.StylesWrapper) *Model {
-	form, values := defaultForm()
+// New creates the session config model. If presetPath is not empty, that preset is loaded on startup.
+// If fromPath is not empty, the form is pre-populated with the session config at that path instead.
+func New(presetPath, fromPath string, styles *styles.StylesWrapper) *Model {
+	form, pages, values := defaultForm()
 	form.WithTheme(styles.Form)
 
 	headerSection := headerSection{focused: false}
 	formSection := formSection{focused: false, form: form}
 	resetButton := resetButton{focused: false}
+	savePresetButton := savePresetButton{focused: false}
 
-	fp := filepicker.New(filepickerID, appdir.AppDirs.UserConfig(), styles, ".json")
+	fp := filepicker.New(filepickerID, appdir.AppDirs.UserConfig(), styles, fileTypes...)
+	saveAs := saveas.New(saveAsID, PresetDir(), styles, fileTypes...)
 
 	return &Model{
 		HeaderSection:    &headerSection,
 		FormSection:      &formSection,
 		ResetButton:      &resetButton,
+		SavePresetButton: &savePresetButton,
 		Filepicker:       fp,
+		SaveAs:           saveAs,
 		form:             form,
+		formPages:        pages,
 		jsonview:         jsonview.New("", styles),
 		styles:           styles,
 		AppStatus:        CreateSessionConfig,
 		configFormValues: values,
+		presetPath:       presetPath,
+		fromPath:         fromPath,
 	}
 }
@@ -0,0 +1,71 @@
+package config
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"charm.land/huh/v2"
+)
+
+// formPages maps each field of a form to the index of the page (group) that it is on, as huh does not expose which
+// group of a form is shown.
+type formPages map[huh.Field]int
+
+// current returns the index of the page that form is showing.
+func (pages formPages) current(form *huh.Form) int {
+	return pages[form.GetFocusedField()]
+}
+
+// total returns the number of pages.
+func (pages formPages) total() int {
+	total := 0
+	for _, page := range pages {
+		total = max(total, page+1)
+	}
+
+	return total
+}
+
+// acceptsDigits reports whether the focused field of form uses digit keys itself, either because it is a text input
+// or because it is a multi-select whose options are being filtered.
+func acceptsDigits(form *huh.Form) bool {
+	switch field := form.GetFocusedField().(type) {
+	case *huh.Input:
+		return true
+
+	case *huh.MultiSelect[string]:
+		return field.GetFiltering()
+	}
+
+	return false
+}
+
+// jumpToPage moves form to the page with the given index, one group at a time so that huh can initialise each group
+// as it would when moving between them normally. If the current group has validation errors, the form stays where it
+// is.
+func jumpToPage(form *huh.Form, pages formPages, target int) tea.Cmd {
+	if target < 0 || target >= pages.total() {
+		return nil
+	}
+
+	cmds := []tea.Cmd{form.GetFocusedField().Blur()}
+
+	for {
+		current := pages.current(form)
+
+		switch {
+		case current < target:
+			cmds = append(cmds, form.NextGroup())
+
+		case current > target:
+			cmds = append(cmds, form.PrevGroup())
+
+		default:
+			return tea.Batch(cmds...)
+		}
+
+		if pages.current(form) == current {
+			// blocked by a validation error
+			cmds = append(cmds, form.GetFocusedField().Focus())
+			return tea.Batch(cmds...)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
+)
+
+// newTestForm returns a session config model with the form focused.
+func newTestForm(t *testing.T) *Model {
+	t.Helper()
+
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+
+	m := New("", "", &s)
+	m.SetWidth(100)
+	m.SetHeight(40)
+	m.Init()
+	m.FormSection.Focus()
+
+	return m
+}
+
+func TestJumpToPage(t *testing.T) {
+	m := newTestForm(t)
+	require.Contains(t, m.View(), "Parts of speech exclusions")
+
+	m.Update(tea.KeyPressMsg{Code: '5', Text: "5"})
+
+	assert.Equal(t, 4, m.formPages.current(m.form))
+	assert.Equal(t, 9, m.formPages.total())
+	assert.Contains(t, m.View(), "Pronoun exclusions")
+	assert.NotContains(t, m.View(), "Parts of speech exclusions")
+
+	// and back again
+	m.Update(tea.KeyPressMsg{Code: '2', Text: "2"})
+
+	assert.Equal(t, 1, m.formPages.current(m.form))
+	assert.Contains(t, m.View(), "Verb exclusions")
+}
+
+func TestJumpToPageOutOfRange(t *testing.T) {
+	m := newTestForm(t)
+
+	for _, target := range []int{-1, 9, 10} {
+		assert.Nilf(t, jumpToPage(m.form, m.formPages, target), "jumped to page %d", target)
+		assert.Equal(t, 0, m.formPages.current(m.form))
+	}
+}
+
+func TestJumpToPageFromInput(t *testing.T) {
+	m := newTestForm(t)
+	m.Update(tea.KeyPressMsg{Code: '9', Text: "9"})
+	require.Equal(t, 8, m.formPages.current(m.form))
+
+	// the number inputs take digits themselves, so they don't change the page
+	m.Update(tea.KeyPressMsg{Code: '2', Text: "2"})
+	assert.Equal(t, 8, m.formPages.current(m.form))
+}
@@ -0,0 +1,98 @@
+package config
+
+// difficultyPreset is a built-in selection of session config settings for a level of experience with Latin.
+type difficultyPreset struct {
+	name     string
+	selected []string // keys that are selected, every other key is unselected
+}
+
+// difficultyPresets are the built-in presets, in order of difficulty.
+var difficultyPresets = []difficultyPreset{
+	{
+		// just the basic forms, with no questions on parsing or inflecting
+		name: "beginner",
+		selected: []string{
+			"exclude-participles",
+			"exclude-gerundives",
+			"exclude-gerunds",
+			"exclude-supines",
+			"exclude-verb-present-passive-indicative",
+			"exclude-verb-imperfect-passive-indicative",
+			"exclude-verb-future-passive-indicative",
+			"exclude-verb-perfect-passive-indicative",
+			"exclude-verb-pluperfect-passive-indicative",
+			"exclude-verb-future-perfect-passive-indicative",
+			"exclude-verb-present-active-subjunctive",
+			"exclude-verb-imperfect-active-subjunctive",
+			"exclude-verb-perfect-active-subjunctive",
+			"exclude-verb-pluperfect-active-subjunctive",
+			"exclude-verb-present-active-imperative",
+			"exclude-verb-future-active-imperative",
+			"exclude-verb-present-passive-imperative",
+			"exclude-verb-future-passive-imperative",
+			"exclude-verb-future-active-infinitive",
+			"exclude-verb-perfect-active-infinitive",
+			"exclude-verb-present-passive-infinitive",
+			"exclude-verb-future-passive-infinitive",
+			"exclude-verb-perfect-passive-infinitive",
+			"exclude-adjective-comparative",
+			"exclude-adjective-superlative",
+			"exclude-adverb-comparative",
+			"exclude-adverb-superlative",
+			"include-typein-engtolat",
+			"include-typein-lattoeng",
+			"include-multiplechoice-engtolat",
+			"include-multiplechoice-lattoeng",
+		},
+	},
+	{
+		// everything apart from the rarer verbal forms
+		name: "intermediate",
+		selected: []string{
+			"exclude-gerundives",
+			"exclude-supines",
+			"exclude-verb-future-active-imperative",
+			"exclude-verb-future-passive-imperative",
+			"exclude-verb-future-passive-infinitive",
+			"include-typein-engtolat",
+			"include-typein-lattoeng",
+			"include-parse",
+			"include-inflect",
+			"include-principal-parts",
+			"include-multiplechoice-engtolat",
+			"include-multiplechoice-lattoeng",
+		},
+	},
+	{
+		// every form, with typed answers only and macrons required
+		name: "advanced",
+		selected: []string{
+			"english-subjunctives",
+			"english-verbal-nouns",
+			"require-macrons",
+			"include-typein-engtolat",
+			"include-typein-lattoeng",
+			"include-parse",
+			"include-inflect",
+			"include-principal-parts",
+		},
+	},
+}
+
+// presetFormValues returns the form values for preset. The number fields are kept from current, as they depend on
+// the vocab list more than on difficulty.
+func presetFormValues(preset difficultyPreset, current *formValues) *formValues {
+	values := defaultFormValues()
+	for _, key := range allKeys {
+		values.set(key, false)
+	}
+
+	for _, key := range preset.selected {
+		values.set(key, true)
+	}
+
+	values.NumberMultipleChoiceOptionsString = current.NumberMultipleChoiceOptionsString
+	values.NumberOfQuestionsString = current.NumberOfQuestionsString
+
+	return values
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"encoding/json/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeginnerPreset(t *testing.T) {
+	current := defaultFormValues()
+	current.NumberMultipleChoiceOptionsString = "4"
+	current.NumberOfQuestionsString = "20"
+
+	values := presetFormValues(difficultyPresets[0], current)
+
+	msg := generateSessionConfig(values)()
+	require.IsType(t, rawSessionConfigMsg{}, msg)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(msg.(rawSessionConfigMsg), &got))
+
+	want := map[string]any{
+		"number-multiplechoice-options": 4.0,
+		"number-of-questions":           20.0,
+	}
+	for _, key := range allKeys {
+		want[key] = false
+	}
+
+	for _, key := range []string{
+		"exclude-participles",
+		"exclude-gerundives",
+		"exclude-gerunds",
+		"exclude-supines",
+		"exclude-verb-present-passive-indicative",
+		"exclude-verb-imperfect-passive-indicative",
+		"exclude-verb-future-passive-indicative",
+		"exclude-verb-perfect-passive-indicative",
+		"exclude-verb-pluperfect-passive-indicative",
+		"exclude-verb-future-perfect-passive-indicative",
+		"exclude-verb-present-active-subjunctive",
+		"exclude-verb-imperfect-active-subjunctive",
+		"exclude-verb-perfect-active-subjunctive",
+		"exclude-verb-pluperfect-active-subjunctive",
+		"exclude-verb-present-active-imperative",
+		"exclude-verb-future-active-imperative",
+		"exclude-verb-present-passive-imperative",
+		"exclude-verb-future-passive-imperative",
+		"exclude-verb-future-active-infinitive",
+		"exclude-verb-perfect-active-infinitive",
+		"exclude-verb-present-passive-infinitive",
+		"exclude-verb-future-passive-infinitive",
+		"exclude-verb-perfect-passive-infinitive",
+		"exclude-adjective-comparative",
+		"exclude-adjective-superlative",
+		"exclude-adverb-comparative",
+		"exclude-adverb-superlative",
+		"include-typein-engtolat",
+		"include-typein-lattoeng",
+		"include-multiplechoice-engtolat",
+		"include-multiplechoice-lattoeng",
+	} {
+		want[key] = true
+	}
+
+	// everything else, e.g. parsing and inflecting, is unselected
+	assert.Equal(t, want, got)
+}
+
+func TestPresetKeysKnown(t *testing.T) {
+	values := defaultFormValues()
+
+	for _, preset := range difficultyPresets {
+		t.Run(preset.name, func(t *testing.T) {
+			for _, key := range preset.selected {
+				assert.Containsf(t, allKeys, key, "%s is not a session config key", key)
+				assert.NotNilf(t, values.fieldFor(key), "%s is not part of the form", key)
+			}
+
+			sorted := slices.Clone(preset.selected)
+			slices.Sort(sorted)
+			assert.Len(t, slices.Compact(sorted), len(preset.selected), "keys are selected more than once")
+
+			// every preset asks some questions
+			assert.True(t, slices.ContainsFunc(preset.selected, func(key string) bool {
+				return strings.HasPrefix(key, "include-")
+			}), "no question types are included")
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/components/filepicker"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/components/navigator"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/components/saveas"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/util"
 )
 
@@ -25,6 +26,7 @@ type configMap map[string]any
 type (
 	formSubmittedMsg    struct{}
 	rawSessionConfigMsg []byte
+	formValuesMsg       struct{ values *formValues }
 
 	// In case there is an error with `generateSessionConfig` to distinguish with `app.ErrMsg`.
 	failFormMsg struct{}
@@ -112,7 +114,7 @@ func generateSessionConfig(values *formValues) tea.Cmd {
 
 func readSessionConfigFile(selectedFile string) tea.Cmd {
 	return func() tea.Msg {
-		rawSessionConfig, err := os.ReadFile(selectedFile)
+		rawSessionConfig, err := ReadSessionConfigFile(selectedFile)
 		if err != nil {
 			return app.ErrMsg(fmt.Errorf("failed to read session config file at %s: %w", selectedFile, err))
 		}
@@ -131,6 +133,39 @@ func readSessionConfigFile(selectedFile string) tea.Cmd {
 	}
 }
 
+// readFormValuesFile reads a session config file to pre-populate the form with, rather than to review.
+func readFormValuesFile(selectedFile string) tea.Cmd {
+	return func() tea.Msg {
+		rawSessionConfig, err := ReadSessionConfigFile(selectedFile)
+		if err != nil {
+			return app.ErrMsg(fmt.Errorf("failed to read session config file at %s: %w", selectedFile, err))
+		}
+
+		values, err := formValuesFromSessionConfig(rawSessionConfig)
+		if err != nil {
+			return app.ErrMsg(fmt.Errorf("failed to read session config file at %s: %w", selectedFile, err))
+		}
+
+		return formValuesMsg{values: values}
+	}
+}
+
+// saveSessionConfig saves a session config to a file, as YAML if the file has a .yaml or .yml extension.
+func saveSessionConfig(filePath, rawSessionConfig string) tea.Cmd {
+	return func() tea.Msg {
+		data, err := encodeSessionConfig(filePath, rawSessionConfig)
+		if err != nil {
+			return app.ErrMsg(fmt.Errorf("failed to save session config to %s: %w", filePath, err))
+		}
+
+		if err := os.WriteFile(filePath, data, 0o644); err != nil {
+			return app.ErrMsg(fmt.Errorf("failed to save session config to %s: %w", filePath, err))
+		}
+
+		return nil
+	}
+}
+
 func (m *Model) Update(msg tea.Msg) (app.ComponentModel, tea.Cmd) {
 	var cmds []tea.Cmd
 
@@ -160,13 +195,56 @@ func (m *Model) Update(msg tea.Msg) (app.ComponentModel, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 	}
 
+	if m.SaveAsActive {
+		switch msg := msg.(type) {
+		case app.RefreshStylesMsg:
+			m.jsonview.Refresh()
+
+		case saveas.SelectedMsg:
+			if msg.ID == saveAsID {
+				m.SaveAsActive = false
+				cmds = append(
+					cmds,
+					saveSessionConfig(msg.Path, m.RawSessionConfig),
+					m.SaveAs.RefreshFilepickerDir(),
+				)
+			}
+
+		case saveas.ExitMsg:
+			if msg.ID == saveAsID {
+				m.SaveAsActive = false
+			}
+		}
+
+		util.UpdaterPtr(&cmds, m.SaveAs, msg)
+
+		return m, tea.Batch(cmds...)
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
+		if m.SavePresetButton.Focused() && key.Matches(msg, m.SavePresetButton.KeyMap().PressButton) {
+			m.SaveAsActive = true
+			return m, nil
+		}
+
+		if m.FormSection.Focused() && m.AppStatus == CreateSessionConfig && !acceptsDigits(m.form) &&
+			key.Matches(msg, m.FormSection.KeyMap().JumpToPage) {
+			page, _ := strconv.Atoi(msg.String())
+			return m, jumpToPage(m.form, m.formPages, page-1)
+		}
+
+		if m.FormSection.Focused() && m.AppStatus == CreateSessionConfig &&
+			key.Matches(msg, m.FormSection.KeyMap().ApplyPreset) {
+			i, _ := strconv.Atoi(strings.TrimPrefix(msg.String(), "alt+"))
+			return m, util.MsgCmd(formValuesMsg{values: presetFormValues(difficultyPresets[i-1], m.configFormValues)})
+		}
+
 		if m.HeaderSection.Focused() && key.Matches(msg, m.HeaderSection.KeyMap().PressButton) {
 			m.FilepickerActive = true
 			return m, nil
 		} else if m.ResetButton.Focused() && key.Matches(msg, m.ResetButton.KeyMap().PressButton) {
-			m.form, m.configFormValues = defaultForm()
+			m.form, m.formPages, m.configFormValues = defaultForm()
 			m.form.WithTheme(m.styles.Form)
 			m.AppStatus = CreateSessionConfig
 			m.RawSessionConfig = ""
@@ -175,7 +253,7 @@ func (m *Model) Update(msg tea.Msg) (app.ComponentModel, tea.Cmd) {
 			return m, tea.Batch(
 				formCmd,
 				util.MsgCmd(navigator.RemoveNavigableMsg{
-					Components: []navigator.Navigable{m.ResetButton},
+					Components: []navigator.Navigable{m.ResetButton, m.SavePresetButton},
 				}),
 			)
 		}
@@ -193,14 +271,25 @@ func (m *Model) Update(msg tea.Msg) (app.ComponentModel, tea.Cmd) {
 	case formSubmittedMsg:
 		cmds = append(cmds, generateSessionConfig(m.configFormValues))
 
+	case formValuesMsg:
+		if m.AppStatus == CreateSessionConfig {
+			m.configFormValues = msg.values
+			m.form, m.formPages = newForm(m.configFormValues)
+			m.form.WithTheme(m.styles.Form)
+			m.FormSection.form = m.form
+			_, formCmd := m.form.Update(nil) // a little nudge
+
+			return m, formCmd
+		}
+
 	case rawSessionConfigMsg:
 		if m.AppStatus == CreateSessionConfig {
 			// navigator: [..., HeaderSection, FormSection, ...]
 			cmds = append(cmds, tea.Sequence(
-				// now navigator: [..., HeaderSection, ResetButton, FormSection, ...]
+				// now navigator: [..., HeaderSection, ResetButton, SavePresetButton, FormSection, ...]
 				util.MsgCmd(navigator.ReplaceNavigableMsg{
 					Target:      m.FormSection,
-					Replacement: []navigator.Navigable{m.ResetButton, m.FormSection},
+					Replacement: []navigator.Navigable{m.ResetButton, m.SavePresetButton, m.FormSection},
 				}),
 				util.MsgCmd(navigator.FocusNavigableMsg{Target: m.FormSection}),
 			))
@@ -211,7 +300,7 @@ func (m *Model) Update(msg tea.Msg) (app.ComponentModel, tea.Cmd) {
 		m.jsonview.SetContent(m.RawSessionConfig)
 
 	case failFormMsg:
-		m.form, m.configFormValues = defaultForm()
+		m.form, m.formPages, m.configFormValues = defaultForm()
 		m.AppStatus = CreateSessionConfig
 		m.RawSessionConfig = ""
 		_, formCmd := m.form.Update(nil) // a little nudge
@@ -219,12 +308,13 @@ func (m *Model) Update(msg tea.Msg) (app.ComponentModel, tea.Cmd) {
 		return m, tea.Batch(
 			formCmd,
 			util.MsgCmd(navigator.RemoveNavigableMsg{
-				Components: []navigator.Navigable{m.ResetButton},
+				Components: []navigator.Navigable{m.ResetButton, m.SavePresetButton},
 			}),
 		)
 
 	default:
 		util.UpdaterPtr(&cmds, m.Filepicker, msg)
+		util.UpdaterPtr(&cmds, m.SaveAs, msg)
 	}
 
 	if m.FormSection.Focused() {
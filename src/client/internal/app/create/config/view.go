@@ -33,17 +33,21 @@ func (m *Model) View() string {
 			Height(m.height - lipgloss.Height(headerSectionView)).
 			Render(m.form.View())
 	} else {
-		resetButtonView := m.styles.Button(true, m.ResetButton.Focused()).MarginLeft(1).Render("Reset form")
+		buttonsView := lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			m.styles.Button(true, m.ResetButton.Focused()).MarginLeft(1).Render("Reset form"),
+			m.styles.Button(true, m.SavePresetButton.Focused()).MarginLeft(1).Render("Save preset"),
+		)
 
 		m.jsonview.SetWidth(m.width - 6)
 		m.jsonview.SetHeight(
-			m.height - lipgloss.Height(headerSectionView) - lipgloss.Height(resetButtonView) - 2,
+			m.height - lipgloss.Height(headerSectionView) - lipgloss.Height(buttonsView) - 2,
 		)
 
 		// NOTE: not specifying width here as jsonview will have the correct width from above
 		formSectionView = m.styles.NormalBorder(m.FormSection.Focused()).Padding(1, 2).
 			Height(m.height - lipgloss.Height(headerSectionView)).
-			Render(lipgloss.JoinVertical(lipgloss.Left, resetButtonView, "", "", m.jsonview.View()))
+			Render(lipgloss.JoinVertical(lipgloss.Left, buttonsView, "", "", m.jsonview.View()))
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Right, headerSectionView, formSectionView)
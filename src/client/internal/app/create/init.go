@@ -23,6 +23,7 @@ func (m *Model) Init() tea.Cmd {
 		components = append(components,
 			m.configtui.HeaderSection,
 			m.configtui.ResetButton,
+			m.configtui.SavePresetButton,
 			m.configtui.FormSection,
 		)
 	}
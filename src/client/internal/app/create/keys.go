@@ -60,7 +60,7 @@ func (m *Model) KeyMap() help.KeyMap {
 	}
 
 	if m.configtui.HeaderSection.Focused() || m.configtui.ResetButton.Focused() ||
-		m.configtui.FormSection.Focused() {
+		m.configtui.SavePresetButton.Focused() || m.configtui.FormSection.Focused() {
 		return m.configtui.KeyMap()
 	}
 
@@ -0,0 +1,37 @@
+package list
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ionut-t/goeditor/core"
+)
+
+// categoryCompletions returns the parts of speech to suggest when the cursor is in a section header, given the text
+// on its line before the cursor. Nothing is suggested outside of a header, once the header is already valid, or when
+// the part of speech typed so far does not match any of them, so that the suggestions can be ignored.
+func categoryCompletions(textBeforeCursor string) []core.Completion {
+	header, ok := strings.CutPrefix(strings.TrimLeft(textBeforeCursor, " \t"), "@")
+	if !ok {
+		return nil
+	}
+
+	typed := strings.TrimLeft(header, " \t")
+	if strings.ContainsAny(typed, " \t") ||
+		slices.Contains(partsOfSpeech, typed) || slices.Contains(partsOfSpeech, strings.TrimSuffix(typed, "s")) {
+		return nil
+	}
+
+	var completions []core.Completion
+
+	for _, partOfSpeech := range partsOfSpeech {
+		if strings.HasPrefix(strings.ToLower(partOfSpeech), strings.ToLower(typed)) {
+			completions = append(completions, core.Completion{
+				Text:  partOfSpeech,
+				Label: partOfSpeech,
+			})
+		}
+	}
+
+	return completions
+}
@@ -0,0 +1,121 @@
+package list
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/exp/teatest/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
+)
+
+func completionTexts(textBeforeCursor string) []string {
+	var texts []string
+	for _, completion := range categoryCompletions(textBeforeCursor) {
+		texts = append(texts, completion.Text)
+	}
+
+	return texts
+}
+
+func TestCategoryCompletions(t *testing.T) {
+	tests := map[string]struct {
+		textBeforeCursor string
+		want             []string
+	}{
+		"EmptyHeader":       {textBeforeCursor: "@ ", want: partsOfSpeech},
+		"NoSpace":           {textBeforeCursor: "@", want: partsOfSpeech},
+		"Indented":          {textBeforeCursor: "  @ ", want: partsOfSpeech},
+		"Prefix":            {textBeforeCursor: "@ No", want: []string{"Noun"}},
+		"PrefixIgnoresCase": {textBeforeCursor: "@ p", want: []string{"Pronoun"}},
+		"SharedPrefix":      {textBeforeCursor: "@ A", want: []string{"Adjective"}},
+		"Unknown":           {textBeforeCursor: "@ Adverb", want: nil},
+		"AlreadyValid":      {textBeforeCursor: "@ Noun", want: nil},
+		"AlreadyValidPlural": {
+			textBeforeCursor: "@ Verbs",
+			want:             nil,
+		},
+		"SeveralWords": {textBeforeCursor: "@ Noun s", want: nil},
+		"Entry":        {textBeforeCursor: "boy: puer", want: nil},
+		"Comment":      {textBeforeCursor: "# @ ", want: nil},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, completionTexts(tt.textBeforeCursor))
+		})
+	}
+}
+
+type model struct {
+	List *Model
+}
+
+func (m model) Init() tea.Cmd {
+	return m.List.Init()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	_, cmd := m.List.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() tea.View {
+	return tea.NewView(m.List.View())
+}
+
+func TestSelectCategoryCompletion(t *testing.T) {
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	l := New("", filepath.Join(t.TempDir(), "list.txt"), false, &s)
+	l.SetWidth(70)
+	l.SetHeight(30)
+	l.VocabEditor.Focus()
+
+	tm := teatest.NewTestModel(t, model{List: l}, teatest.WithInitialTermSize(70, 30))
+
+	tm.Type("@ No")
+	teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+		return bytes.Contains(bts, []byte("Noun"))
+	}, teatest.WithDuration(3*time.Second))
+
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyTab})
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	tm.Type("boy")
+
+	if err := tm.Quit(); err != nil {
+		t.Fatal(err)
+	}
+
+	final := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	assert.Equal(t, "@ Noun\nboy", final.List.VocabEditor.GetCurrentContent())
+}
+
+func TestIgnoreCategoryCompletions(t *testing.T) {
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	l := New("", filepath.Join(t.TempDir(), "list.txt"), false, &s)
+	l.SetWidth(70)
+	l.SetHeight(30)
+	l.VocabEditor.Focus()
+
+	tm := teatest.NewTestModel(t, model{List: l}, teatest.WithInitialTermSize(70, 30))
+
+	tm.Type("@ ")
+	teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+		return bytes.Contains(bts, []byte("Pronoun"))
+	}, teatest.WithDuration(3*time.Second))
+
+	// enter starts a new line rather than accepting the first suggestion
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	tm.Type("boy")
+
+	if err := tm.Quit(); err != nil {
+		t.Fatal(err)
+	}
+
+	final := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+	assert.Equal(t, "@ \nboy", final.List.VocabEditor.GetCurrentContent())
+}
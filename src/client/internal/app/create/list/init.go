@@ -1,15 +1,28 @@
 package list
 
 import (
+	"os"
+
 	tea "charm.land/bubbletea/v2"
 )
 
 func (m *Model) Init() tea.Cmd {
-	return tea.Batch(
+	cmds := []tea.Cmd{
 		m.VocabEditor.Init(),
 		m.VocabEditor.CursorBlink(),
 		m.ModeDropdown.Init(),
 		m.Filepicker.Init(),
 		m.SaveAs.Init(),
-	)
+	}
+
+	// only load the list the first time, so that it doesn't override any changes
+	if m.editPath != "" {
+		if _, err := os.Stat(m.editPath); err == nil {
+			cmds = append(cmds, readVocabList(m.editPath))
+		}
+
+		m.editPath = ""
+	}
+
+	return tea.Batch(cmds...)
 }
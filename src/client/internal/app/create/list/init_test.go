@@ -0,0 +1,64 @@
+package list
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
+)
+
+func TestEditExistingList(t *testing.T) {
+	const list = "@ Noun\npuer, puerī, (m) : boy\n"
+
+	tests := map[string]struct {
+		exists      bool
+		wantContent string
+	}{
+		"Existing": {exists: true, wantContent: "@ Noun\npuer, puerī, (m) : boy"}, // without the trailing newline
+		"Missing":  {exists: false, wantContent: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "list.txt")
+
+			if tt.exists {
+				require.NoError(t, os.WriteFile(path, []byte(list), 0o600))
+			}
+
+			s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+			l := New("", path, false, &s)
+			l.SetWidth(70)
+			l.SetHeight(30)
+
+			tm := teatest.NewTestModel(t, model{List: l}, teatest.WithInitialTermSize(70, 30))
+
+			if tt.exists {
+				teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+					return bytes.Contains(bts, []byte("puerī"))
+				}, teatest.WithDuration(3*time.Second))
+			}
+
+			if err := tm.Quit(); err != nil {
+				t.Fatal(err)
+			}
+
+			final := tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model)
+			assert.Equal(t, CustomList, final.List.AppStatus)
+			assert.Equal(t, tt.wantContent, final.List.VocabEditor.GetCurrentContent())
+
+			// saving defaults to the same file
+			saveAsView, _, _ := final.List.SaveAs.View(70, 30)
+			assert.Contains(t, saveAsView, dir)
+			assert.Contains(t, saveAsView, "list.txt")
+		})
+	}
+}
@@ -3,6 +3,7 @@ package list
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/ionut-t/goeditor"
 
@@ -80,6 +81,11 @@ type Model struct {
 	ModeDropdownActive bool
 	SaveAsActive       bool
 	inbuiltListDir     string
+	editPath           string              // list to load for editing on startup, if not empty
+	tidy               bool                // whether to tidy the custom list (see tidyVocabList) when it is saved
+	validationErr      error               // the first problem with the custom list being edited, if any
+	duplicates         []duplicatedMeaning // meanings that appear more than once in the custom list being edited
+	completing         bool                // whether part of speech suggestions were last given to the editor
 }
 
 const (
@@ -88,7 +94,10 @@ const (
 	saveAsID       = "listtuiSaveAs"
 )
 
-func New(inbuiltListDir string, styles *styles.StylesWrapper) *Model {
+// New creates the vocab list model. If editPath is not empty, the list at that path is opened as a custom list to
+// edit, and is saved back to the same path by default. If it does not exist yet, the list starts off empty. If tidy is
+// true, the spacing of the custom list is normalised whenever it is saved.
+func New(inbuiltListDir, editPath string, tidy bool, styles *styles.StylesWrapper) *Model {
 	headerSection := headerSection{focused: false}
 	ed := goeditor.New(0, 0) // placeholder size values
 
@@ -99,6 +108,7 @@ func New(inbuiltListDir string, styles *styles.StylesWrapper) *Model {
 	ed.DisableSearchMode(true)
 
 	ed.SetCursorMode(goeditor.CursorBlink)
+	ed.WithCompletionAutoTrigger(true) // suggests parts of speech in section headers, see categoryCompletions
 	ed.SetLanguage("vocabfile", "bubbletint_vocabeditor")
 	ed.WithTheme(styles.Editor.Theme)
 
@@ -113,6 +123,18 @@ func New(inbuiltListDir string, styles *styles.StylesWrapper) *Model {
 	homeDir, _ := os.UserHomeDir()
 	saveAs := saveas.New(saveAsID, homeDir, styles, ".txt")
 
+	appStatus := InbuiltList
+	if editPath != "" {
+		appStatus = CustomList
+		modeDropdown.LastSelected = CustomList
+
+		ed.DisableInsertMode(false)
+		ed.SetInsertMode()
+
+		saveAs = saveas.New(saveAsID, filepath.Dir(editPath), styles, ".txt")
+		saveAs.SetFilename(filepath.Base(editPath))
+	}
+
 	return &Model{
 		HeaderSection: &headerSection,
 		VocabEditor:   &editorWrapper{Model: ed},
@@ -123,7 +145,9 @@ func New(inbuiltListDir string, styles *styles.StylesWrapper) *Model {
 		SaveAs:       saveAs,
 
 		styles:         styles,
-		AppStatus:      InbuiltList,
+		AppStatus:      appStatus,
 		inbuiltListDir: inbuiltListDir,
+		editPath:       editPath,
+		tidy:           tidy,
 	}
 }
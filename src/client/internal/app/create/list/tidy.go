@@ -0,0 +1,85 @@
+package list
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// tidyVocabList normalises the spacing of a vocab list: surrounding whitespace is trimmed from each line, sections are
+// separated by exactly one blank line (with no more than one blank line in a row anywhere else), and the Latin parts
+// of the entries in each section are aligned. The list always ends with a single newline.
+func tidyVocabList(list string) string {
+	var (
+		sections [][]string // lines of each section, the first of which is its header (apart from before any header)
+		current  []string
+	)
+
+	for line := range strings.SplitSeq(list, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "@") {
+			sections = append(sections, current)
+			current = nil
+		}
+
+		// collapse runs of blank lines, and drop any at the start of a section or straight after its header
+		if line == "" && (len(current) == 0 || current[len(current)-1] == "" ||
+			strings.HasPrefix(current[len(current)-1], "@")) {
+			continue
+		}
+
+		current = append(current, line)
+	}
+
+	sections = append(sections, current)
+
+	var blocks []string
+
+	for _, lines := range sections {
+		for len(lines) > 0 && lines[len(lines)-1] == "" {
+			lines = lines[:len(lines)-1]
+		}
+
+		if len(lines) > 0 {
+			blocks = append(blocks, strings.Join(alignEntries(lines), "\n"))
+		}
+	}
+
+	if len(blocks) == 0 {
+		return ""
+	}
+
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+// alignEntries pads the meanings of the entries in lines so that their Latin parts line up. Headers, comments, blank
+// lines and malformed lines are left as they are.
+func alignEntries(lines []string) []string {
+	isEntry := func(line string) bool {
+		return line != "" && !strings.HasPrefix(line, "#") && !strings.HasPrefix(line, "@") &&
+			strings.Contains(line, ":")
+	}
+
+	width := 0
+
+	for _, line := range lines {
+		if isEntry(line) {
+			meaning, _, _ := strings.Cut(line, ":")
+			width = max(width, lipgloss.Width(strings.TrimSpace(meaning)))
+		}
+	}
+
+	aligned := make([]string, len(lines))
+	for i, line := range lines {
+		aligned[i] = line
+
+		if isEntry(line) {
+			meaning, latin, _ := strings.Cut(line, ":")
+			meaning = strings.TrimSpace(meaning)
+			padding := strings.Repeat(" ", width-lipgloss.Width(meaning))
+			aligned[i] = meaning + ":" + padding + " " + strings.TrimSpace(latin)
+		}
+	}
+
+	return aligned
+}
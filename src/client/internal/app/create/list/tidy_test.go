@@ -0,0 +1,55 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTidyVocabList(t *testing.T) {
+	tests := map[string]struct {
+		list string
+		want string
+	}{
+		"AlreadyTidy": {
+			list: "@ Noun\nboy: puer, pueri, m\n",
+			want: "@ Noun\nboy: puer, pueri, m\n",
+		},
+		"TrailingWhitespace": {
+			list: "@ Noun   \nboy: puer, pueri, m  \t\n",
+			want: "@ Noun\nboy: puer, pueri, m\n",
+		},
+		"AlignsEntriesPerSection": {
+			list: "@ Noun\nboy: puer, pueri, m\ngirl:puella, puellae, f\n@ Verb\nlove: amo, amare, amavi, amatus\n",
+			want: "@ Noun\nboy:  puer, pueri, m\ngirl: puella, puellae, f\n\n@ Verb\nlove: amo, amare, amavi, amatus\n",
+		},
+		"BlankLinesBetweenSections": {
+			list: "\n\n@ Noun\n\nboy: puer, pueri, m\n\n\n\n@ Verb\n\n\nlove: amo, amare, amavi, amatus\n\n\n",
+			want: "@ Noun\nboy: puer, pueri, m\n\n@ Verb\nlove: amo, amare, amavi, amatus\n",
+		},
+		"BlankLinesWithinSectionCollapsed": {
+			list: "@ Noun\nboy: puer, pueri, m\n\n\n\ngirl: puella, puellae, f\n",
+			want: "@ Noun\nboy:  puer, pueri, m\n\ngirl: puella, puellae, f\n",
+		},
+		"CommentsKept": {
+			list: "# my list  \n@ Regular\n  # adverbs\nwell: bene\nalways: semper\n",
+			want: "# my list\n\n@ Regular\n# adverbs\nwell:   bene\nalways: semper\n",
+		},
+		"MalformedLineKept": {
+			list: "@ Noun\nboy puer\nboy: puer, pueri, m\n",
+			want: "@ Noun\nboy puer\nboy: puer, pueri, m\n",
+		},
+		"Empty": {list: "\n\n", want: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tidyVocabList(tt.list)
+			assert.Equal(t, tt.want, got)
+
+			// tidying is idempotent, and tidy lists are still valid
+			assert.Equal(t, tt.want, tidyVocabList(got))
+			assert.Equal(t, len(ValidateVocabList(tt.list)), len(ValidateVocabList(got)))
+		})
+	}
+}
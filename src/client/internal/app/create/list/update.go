@@ -6,6 +6,7 @@ import (
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
+	"github.com/ionut-t/goeditor"
 	"github.com/ionut-t/goeditor/highlighter"
 
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
@@ -132,10 +133,14 @@ func (m *Model) Update(msg tea.Msg) (app.ComponentModel, tea.Cmd) {
 		case saveas.SelectedMsg:
 			if msg.ID == saveAsID {
 				m.SaveAsActive = false
-				cmds = append(
-					cmds,
-					saveVocabList(msg.Path, m.VocabEditor.GetCurrentContent()),
-				)
+
+				content := m.VocabEditor.GetCurrentContent()
+				if m.tidy {
+					content = tidyVocabList(content)
+					m.VocabEditor.SetContent(content)
+				}
+
+				cmds = append(cmds, saveVocabList(msg.Path, content))
 				cmds = append(cmds, m.SaveAs.RefreshFilepickerDir())
 			}
 
@@ -165,18 +170,40 @@ func (m *Model) Update(msg tea.Msg) (app.ComponentModel, tea.Cmd) {
 					return m, nil
 
 				case CustomList:
+					if m.validationErr != nil {
+						return m, util.MsgCmd(app.ErrMsg(
+							fmt.Errorf("cannot save invalid vocab list: %w", m.validationErr),
+						))
+					}
+
 					m.SaveAsActive = true
+
 					return m, nil
 				}
 			}
 		}
 
+		// The editor accepts suggestions with enter as well as tab, but enter should still start a new line, so the
+		// suggestions are dismissed first. While suggesting, esc only dismisses them rather than going to normal mode.
+		if m.completing && (msg.String() == "esc" || msg.String() == "enter") {
+			m.completing = false
+			m.VocabEditor.Model, cmd = m.VocabEditor.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+			cmds = append(cmds, cmd)
+		} else if msg.String() == "tab" {
+			m.completing = false
+		}
+
 		// NOTE: Normal mode cannot be disabled in the editor,
 		// so have to manually prevent escaping to normal mode
 		if msg.String() == "esc" {
 			return m, tea.Batch(cmds...)
 		}
 
+	case goeditor.CompletionRequestMsg:
+		completions := categoryCompletions(msg.Context.TextBeforeCursor)
+		m.completing = len(completions) > 0
+		m.VocabEditor.SetCompletions(completions, msg.Context)
+
 	case app.RefreshStylesMsg:
 		m.VocabEditor.WithTheme(m.styles.Editor.Theme)
 		m.VocabEditor.WithSyntaxHighlighter(highlighter.New("vocabfile", "bubbletint_vocabeditor"))
@@ -193,5 +220,16 @@ func (m *Model) Update(msg tea.Msg) (app.ComponentModel, tea.Cmd) {
 	m.VocabEditor.Model, cmd = m.VocabEditor.Update(msg)
 	cmds = append(cmds, cmd)
 
+	m.validationErr = nil
+	m.duplicates = nil
+
+	if m.AppStatus == CustomList {
+		content := m.VocabEditor.GetCurrentContent()
+		if errs := ValidateVocabList(content); len(errs) > 0 {
+			m.validationErr = errs[0]
+		}
+		m.duplicates = findDuplicates(content)
+	}
+
 	return m, tea.Batch(cmds...)
 }
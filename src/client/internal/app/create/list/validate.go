@@ -0,0 +1,204 @@
+package list
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// partsOfSpeech are the parts of speech that can be given in a section header, in their singular form.
+var partsOfSpeech = []string{"Verb", "Adjective", "Noun", "Regular", "Pronoun"}
+
+var (
+	genders             = []string{"m", "f", "n", "masculine", "feminine", "neuter"}
+	adjectiveDeclension = regexp.MustCompile(`^(212|2-1-2|3-.)$`)
+
+	// numberParts is the number of Latin parts allowed for each part of speech that has a fixed number of parts.
+	numberParts = map[string][]int{
+		"Verb":      {1, 3, 4},
+		"Noun":      {1, 3},
+		"Adjective": {3, 4},
+	}
+)
+
+// ValidateVocabList checks that a vocab list is in the format expected by the server, returning an error describing
+// each malformed line, in order.
+func ValidateVocabList(list string) []error {
+	var (
+		current string
+		errs    []error
+	)
+
+	for i, line := range strings.Split(list, "\n") {
+		lineNumber := i + 1
+
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if header, ok := strings.CutPrefix(line, "@"); ok {
+			header = strings.TrimSpace(header)
+
+			// entries under an invalid header are still checked, but not against any part of speech
+			current = strings.TrimSuffix(header, "s")
+			if !slices.Contains(partsOfSpeech, current) {
+				errs = append(errs, fmt.Errorf("line %d: invalid part of speech %q", lineNumber, header))
+			}
+
+			continue
+		}
+
+		if current == "" {
+			errs = append(errs, fmt.Errorf("line %d: part of speech was not given", lineNumber))
+			continue
+		}
+
+		meaning, latin, ok := strings.Cut(line, ":")
+		if !ok || strings.Contains(latin, ":") || strings.TrimSpace(meaning) == "" {
+			errs = append(errs, fmt.Errorf("line %d: expected a line of the form \"meaning: latin, ...\"", lineNumber))
+			continue
+		}
+
+		if err := validateLatinParts(current, strings.Split(latin, ",")); err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", lineNumber, err))
+		}
+	}
+
+	return errs
+}
+
+// duplicateEntry is an entry of a vocab list whose meaning was already given by an earlier entry in the same section.
+type duplicateEntry struct {
+	meaning   string
+	line      int
+	firstLine int // the line the meaning was first given on
+}
+
+// duplicateEntries returns every entry of a vocab list that repeats the meaning of an earlier entry in the same
+// section, in order. The same meaning under different parts of speech (e.g. a noun and a verb) is not a duplicate.
+func duplicateEntries(list string) []duplicateEntry {
+	var (
+		firstLines = make(map[string]int)
+		duplicates []duplicateEntry
+	)
+
+	for i, line := range strings.Split(list, "\n") {
+		lineNumber := i + 1
+
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "@") {
+			firstLines = make(map[string]int)
+			continue
+		}
+
+		meaning, _, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		meaning = strings.TrimSpace(meaning)
+		if firstLine, seen := firstLines[meaning]; seen {
+			duplicates = append(duplicates, duplicateEntry{meaning: meaning, line: lineNumber, firstLine: firstLine})
+			continue
+		}
+
+		firstLines[meaning] = lineNumber
+	}
+
+	return duplicates
+}
+
+// duplicatedMeaning is a meaning given by more than one entry in the same section of a vocab list.
+type duplicatedMeaning struct {
+	meaning string
+	lines   []int
+}
+
+// findDuplicates returns the meanings that are given more than once in the same section of a vocab list, with the
+// lines they are given on, in the order they are first repeated.
+func findDuplicates(list string) []duplicatedMeaning {
+	var (
+		duplicates []duplicatedMeaning
+		indices    = make(map[int]int) // first line of a meaning -> index in duplicates
+	)
+
+	for _, d := range duplicateEntries(list) {
+		i, ok := indices[d.firstLine]
+		if !ok {
+			i = len(duplicates)
+			indices[d.firstLine] = i
+			duplicates = append(duplicates, duplicatedMeaning{meaning: d.meaning, lines: []int{d.firstLine}})
+		}
+
+		duplicates[i].lines = append(duplicates[i].lines, d.line)
+	}
+
+	return duplicates
+}
+
+// DuplicateEntries returns an error for each entry of a vocab list that repeats the meaning of an earlier entry in the
+// same section, in order. Duplicates are accepted by the server, so these are not reported by [ValidateVocabList].
+func DuplicateEntries(list string) []error {
+	duplicates := duplicateEntries(list)
+
+	errs := make([]error, len(duplicates))
+	for i, d := range duplicates {
+		errs[i] = fmt.Errorf("line %d: duplicate entry %q (first given on line %d)", d.line, d.meaning, d.firstLine)
+	}
+
+	return errs
+}
+
+// validateLatinParts checks that the Latin parts of an entry are valid for the given part of speech.
+func validateLatinParts(partOfSpeech string, parts []string) error {
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+
+	want, ok := numberParts[partOfSpeech]
+	if ok && !slices.Contains(want, len(parts)) {
+		return fmt.Errorf(
+			"expected %s parts for %s, got %d",
+			joinNumbers(want),
+			strings.ToLower(partOfSpeech),
+			len(parts),
+		)
+	}
+
+	switch partOfSpeech {
+	case "Noun":
+		if len(parts) == 3 {
+			fields := strings.Fields(parts[2])
+			if len(fields) == 0 || !slices.Contains(genders, strings.Trim(fields[len(fields)-1], "()")) {
+				return fmt.Errorf("invalid gender %q", parts[2])
+			}
+		}
+
+	case "Adjective":
+		if declension := strings.Trim(parts[len(parts)-1], "()"); !adjectiveDeclension.MatchString(declension) {
+			return fmt.Errorf("invalid adjective declension %q", declension)
+		}
+	}
+
+	return nil
+}
+
+// joinNumbers formats numbers as a list of alternatives, e.g. "1, 3 or 4".
+func joinNumbers(numbers []int) string {
+	strs := make([]string, len(numbers))
+	for i, n := range numbers {
+		strs[i] = fmt.Sprint(n)
+	}
+
+	if len(strs) == 1 {
+		return strs[0]
+	}
+
+	return strings.Join(strs[:len(strs)-1], ", ") + " or " + strs[len(strs)-1]
+}
@@ -0,0 +1,160 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateVocabList(t *testing.T) {
+	tests := map[string]struct {
+		list    string
+		wantErr string
+	}{
+		"Valid": {
+			list: `# comment
+@ Verbs
+walk: ambulo, ambulare, ambulavi, ambulatus
+be: sum, esse, fui, futurus
+be able: possum
+
+@ Nouns
+friend: amicus, amici, (m)
+thing: res, rei, (feminine)
+
+@ Adjectives
+good: bonus, bona, bonum, (2-1-2)
+large: ingens, ingentis, (3-1)
+
+@ Regular
+and: et
+
+@ Pronoun
+that: ille
+`,
+		},
+		"Empty": {list: ""},
+		"InvalidPartOfSpeech": {
+			list:    "@ Verbs\nwalk: ambulo\n@ Adverbs\nwell: bene",
+			wantErr: `line 3: invalid part of speech "Adverbs"`,
+		},
+		"NoPartOfSpeech": {
+			list:    "walk: ambulo, ambulare, ambulavi, ambulatus",
+			wantErr: "line 1: part of speech was not given",
+		},
+		"NoColon": {
+			list:    "@ Regulars\nand et",
+			wantErr: "line 2: expected a line",
+		},
+		"TooManyColons": {
+			list:    "@ Regulars\nand: et: que",
+			wantErr: "line 2: expected a line",
+		},
+		"WrongNumberOfVerbParts": {
+			list:    "@ Verbs\nwalk: ambulo, ambulare",
+			wantErr: "line 2: expected 1, 3 or 4 parts for verb, got 2",
+		},
+		"WrongNumberOfNounParts": {
+			list:    "@ Nouns\n\nfriend: amicus, amici",
+			wantErr: "line 3: expected 1 or 3 parts for noun, got 2",
+		},
+		"InvalidGender": {
+			list:    "@ Nouns\nfriend: amicus, amici, (x)",
+			wantErr: `line 2: invalid gender "(x)"`,
+		},
+		"InvalidDeclension": {
+			list:    "@ Adjectives\ngood: bonus, bona, bonum, (4)",
+			wantErr: `line 2: invalid adjective declension "4"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := ValidateVocabList(tt.list)
+			if tt.wantErr == "" {
+				assert.Empty(t, errs)
+				return
+			}
+
+			if assert.NotEmpty(t, errs) {
+				assert.Contains(t, errs[0].Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateVocabListMultipleErrors(t *testing.T) {
+	list := `@ Adverbs
+well: bene
+@ Nouns
+friend: amicus, amici
+girl: puella, puellae, (f)
+thing: res, rei, (x)
+`
+
+	errs := ValidateVocabList(list)
+	if assert.Len(t, errs, 3) {
+		assert.Contains(t, errs[0].Error(), "line 1: invalid part of speech")
+		assert.Contains(t, errs[1].Error(), "line 4: expected 1 or 3 parts")
+		assert.Contains(t, errs[2].Error(), "line 6: invalid gender")
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	list := `@ Nouns
+boy: puer, pueri, (m)
+girl: puella, puellae, (f)
+boy: puer, pueri, (m)
+
+@ Verbs
+# boy: not an entry
+love: amo, amare, amavi, amatus
+boy : puer, pueri, (m)
+love: diligo, diligere, dilexi, dilectus
+love: amo, amare, amavi, amatus
+`
+
+	duplicates := findDuplicates(list)
+	assert.Equal(t, []duplicatedMeaning{
+		{meaning: "boy", lines: []int{2, 4}},
+		{meaning: "love", lines: []int{8, 10, 11}},
+	}, duplicates)
+	assert.Equal(t, "Duplicate entries: boy (lines 2, 4), love (lines 8, 10, 11)", duplicatesWarning(duplicates))
+
+	assert.Empty(t, findDuplicates("@ Nouns\nboy: puer, pueri, (m)\ngirl: puella, puellae, (f)"))
+}
+
+func TestFindDuplicatesAcrossSections(t *testing.T) {
+	list := `@ Nouns
+love: amor, amoris, (m)
+
+@ Verbs
+love: amo, amare, amavi, amatus
+
+@ Nouns
+love: amor, amoris, (m)
+`
+
+	assert.Empty(t, findDuplicates(list))
+	assert.Empty(t, DuplicateEntries(list))
+}
+
+func TestDuplicateEntries(t *testing.T) {
+	list := `@ Nouns
+boy: puer, pueri, (m)
+girl: puella, puellae, (f)
+boy: puer, pueri, (m)
+boy : puer, pueri, (m)
+
+@ Verbs
+boy: puer, pueri, (m)
+`
+
+	errs := DuplicateEntries(list)
+	require.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], `line 4: duplicate entry "boy" (first given on line 2)`)
+	assert.EqualError(t, errs[1], `line 5: duplicate entry "boy" (first given on line 2)`)
+
+	assert.Empty(t, DuplicateEntries("@ Nouns\nboy: puer, pueri, (m)\ngirl: puella, puellae, (f)"))
+}
@@ -1,6 +1,11 @@
 package list
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
 	"charm.land/lipgloss/v2"
 )
 
@@ -27,6 +32,80 @@ func selectListText(status createListStatus) string {
 	panic("unreachable")
 }
 
+// HeaderCount is the number of entries under a section header.
+type HeaderCount struct {
+	Header string
+	Count  int
+}
+
+// CountEntries returns the number of lines in a vocab list that are entries, i.e. not blank, a section header or
+// a comment, along with the number of entries under each section header in the order they first appear.
+func CountEntries(lines []string) (total int, byHeader []HeaderCount) {
+	current := -1
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if header, ok := strings.CutPrefix(line, "@"); ok {
+			header = strings.TrimSpace(header)
+
+			current = slices.IndexFunc(byHeader, func(hc HeaderCount) bool { return hc.Header == header })
+			if current == -1 {
+				byHeader = append(byHeader, HeaderCount{Header: header})
+				current = len(byHeader) - 1
+			}
+
+			continue
+		}
+
+		total++
+
+		if current != -1 {
+			byHeader[current].Count++
+		}
+	}
+
+	return total, byHeader
+}
+
+// editorStatusText returns the current line number, total number of lines and number of entries in the editor,
+// broken down by section header.
+func (m *Model) editorStatusText() string {
+	lines := strings.Split(m.VocabEditor.GetCurrentContent(), "\n")
+	total, byHeader := CountEntries(lines)
+
+	text := fmt.Sprintf("Ln %d/%d, %d entries", m.VocabEditor.GetCursorPosition().Row+1, len(lines), total)
+	if len(byHeader) > 0 {
+		counts := make([]string, len(byHeader))
+		for i, hc := range byHeader {
+			counts[i] = fmt.Sprintf("%s: %d", hc.Header, hc.Count)
+		}
+
+		text += " (" + strings.Join(counts, ", ") + ")"
+	}
+
+	return text
+}
+
+// duplicatesWarning returns a warning listing the meanings that appear more than once in the same section of a vocab
+// list, and the lines they appear on.
+func duplicatesWarning(duplicates []duplicatedMeaning) string {
+	strs := make([]string, len(duplicates))
+	for i, d := range duplicates {
+		lines := make([]string, len(d.lines))
+		for j, line := range d.lines {
+			lines[j] = strconv.Itoa(line)
+		}
+
+		strs[i] = fmt.Sprintf("%s (lines %s)", d.meaning, strings.Join(lines, ", "))
+	}
+
+	return "Duplicate entries: " + strings.Join(strs, ", ")
+}
+
 func (m *Model) View() string {
 	// Header section
 	titleView := m.styles.Bold.Render("Vocab List")
@@ -40,9 +119,27 @@ func (m *Model) View() string {
 	selectListView := m.styles.Button(true, m.SelectButton.Focused()).
 		MarginLeft(1).
 		Render(selectListText(m.AppStatus))
+	statusView := m.styles.Faint.MarginLeft(2).Render(m.editorStatusText())
+	footerContent := lipgloss.JoinHorizontal(lipgloss.Center, footerView, selectListView, statusView)
+	if m.validationErr != nil {
+		footerContent = lipgloss.JoinVertical(
+			lipgloss.Left,
+			footerContent,
+			m.styles.Error.Width(m.width-2).Render(m.validationErr.Error()),
+		)
+	}
+
+	if len(m.duplicates) > 0 {
+		footerContent = lipgloss.JoinVertical(
+			lipgloss.Left,
+			footerContent,
+			m.styles.Warning.Width(m.width-2).Render(duplicatesWarning(m.duplicates)),
+		)
+	}
+
 	footerSectionView := m.styles.NormalBorder(m.SelectButton.Focused()).
 		Width(m.width).
-		Render(lipgloss.JoinHorizontal(lipgloss.Center, footerView, selectListView))
+		Render(footerContent)
 
 	// Editor section
 	m.VocabEditor.SetSize(
@@ -0,0 +1,30 @@
+package list
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountEntries(t *testing.T) {
+	list := `# comment
+and: et
+
+@ Verbs
+walk: ambulo, ambulare, ambulavi, ambulatus
+love: amo, amare, amavi, amatus
+
+@ Nouns
+
+friend: amicus, amici, (m)
+
+@ Verbs
+be: sum, esse, fui, futurus
+`
+
+	total, byHeader := CountEntries(strings.Split(list, "\n"))
+
+	assert.Equal(t, 5, total)
+	assert.Equal(t, []HeaderCount{{Header: "Verbs", Count: 3}, {Header: "Nouns", Count: 1}}, byHeader)
+}
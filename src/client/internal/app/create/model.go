@@ -1,6 +1,7 @@
 package create
 
 import (
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create/config"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create/list"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
@@ -38,6 +39,14 @@ func (ls *verifySection) Enabled() bool {
 	return ls.ListStatus != StatusMissing && ls.ConfigStatus != StatusMissing
 }
 
+// Options configures what the create page starts off with.
+type Options struct {
+	ListPath   string // vocab list to open for editing, if not empty
+	TidyList   bool   // whether to normalise the spacing of the vocab list when it is saved
+	PresetPath string // session config preset to load, if not empty
+	FromPath   string // session config to pre-populate the config form with, if not empty
+}
+
 type Model struct {
 	// Layout state
 
@@ -53,12 +62,12 @@ type Model struct {
 
 	styles         *styles.StylesWrapper
 	inbuiltListDir string
-	serverPort     int
+	server         app.ServerOptions
 }
 
-func New(inbuiltListDir string, serverPort int, styles *styles.StylesWrapper) *Model {
-	listtui := list.New(inbuiltListDir, styles)
-	configtui := config.New(styles)
+func New(inbuiltListDir string, options Options, server app.ServerOptions, styles *styles.StylesWrapper) *Model {
+	listtui := list.New(inbuiltListDir, options.ListPath, options.TidyList, styles)
+	configtui := config.New(options.PresetPath, options.FromPath, styles)
 	verifySection := verifySection{focused: false, ListStatus: StatusMissing, ConfigStatus: StatusMissing}
 
 	return &Model{
@@ -68,6 +77,6 @@ func New(inbuiltListDir string, serverPort int, styles *styles.StylesWrapper) *M
 
 		styles:         styles,
 		inbuiltListDir: inbuiltListDir,
-		serverPort:     serverPort,
+		server:         server,
 	}
 }
@@ -2,18 +2,15 @@ package create
 
 import (
 	"context"
-	"encoding/json/v2"
 	"errors"
 	"fmt"
-	"strings"
 
 	tea "charm.land/bubbletea/v2"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
 	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
 )
 
@@ -27,6 +24,7 @@ type ListConfigPostedMsg struct {
 	VocabList         string
 	SessionConfig     *pb.SessionConfig
 	NumberOfQuestions int
+	Marking           questions.MarkingOptions
 }
 
 func postVocabList(vocabList string, client pb.VocabTesterServiceClient) (string, error) {
@@ -34,7 +32,13 @@ func postVocabList(vocabList string, client pb.VocabTesterServiceClient) (string
 		return "", errors.New("vocab list is empty")
 	}
 
-	_, err := client.VerifyVocab(context.Background(), &pb.VerifyVocabRequest{VocabText: vocabList})
+	_, err := app.Retry(context.Background(), func(ctx context.Context) (*pb.VerifyVocabResponse, error) {
+		return client.VerifyVocab(
+			ctx,
+			&pb.VerifyVocabRequest{VocabText: vocabList},
+			app.VocabCallOptions(vocabList)...,
+		)
+	})
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok {
@@ -57,73 +61,39 @@ func postVocabList(vocabList string, client pb.VocabTesterServiceClient) (string
 	return vocabList, nil
 }
 
-func postSessionConfig(rawSessionConfig string, client pb.VocabTesterServiceClient) (*pb.SessionConfig, int, error) {
-	var (
-		mapSessionConfig  map[string]any
-		numberOfQuestions int
-	)
-
-	err := json.Unmarshal([]byte(rawSessionConfig), &mapSessionConfig)
+func postSessionConfig(rawSessionConfig string, client pb.VocabTesterServiceClient) (
+	*pb.SessionConfig,
+	int,
+	questions.MarkingOptions,
+	error,
+) {
+	sessionConfigStruct, numberOfQuestions, marking, err := ParseSessionConfig([]byte(rawSessionConfig))
 	if err != nil {
-		return nil, 0, fmt.Errorf(
-			"failed to unmarshal session config: %w", err,
-		)
+		return nil, 0, questions.MarkingOptions{}, err
 	}
 
-	if x, ok := mapSessionConfig["number-of-questions"]; ok {
-		var y float64
-		if y, ok = x.(float64); !ok {
-			return nil, 0, errors.New(
-				"session config does not contain number-of-questions (did not get integer)",
-			)
-		}
-
-		numberOfQuestions = int(y)
-
-		delete(mapSessionConfig, "number-of-questions")
-	} else {
-		return nil, 0, errors.New("session config does not contain number-of-questions")
+	if errs := ValidateSessionConfig(sessionConfigStruct, numberOfQuestions); len(errs) > 0 {
+		return nil, 0, questions.MarkingOptions{}, fmt.Errorf("invalid session config: %w", errors.Join(errs...))
 	}
 
-	formattedSessionConfig := make(map[string]any)
-	for k, v := range mapSessionConfig {
-		formattedSessionConfig[strings.ReplaceAll(k, "-", "_")] = v
-	}
-
-	formattedSessionConfigJSON, err := json.Marshal(formattedSessionConfig)
-	if err != nil {
-		return nil, 0, fmt.Errorf(
-			"failed to marshal session config after formatting: %w",
-			err,
+	_, err = app.Retry(context.Background(), func(ctx context.Context) (*pb.VerifyConfigResponse, error) {
+		return client.VerifyConfig(
+			ctx,
+			&pb.VerifyConfigRequest{
+				NumberOfQuestions: int32(numberOfQuestions),
+				SessionConfig:     sessionConfigStruct,
+			},
 		)
-	}
-
-	var sessionConfigStruct pb.SessionConfig
-
-	err = json.Unmarshal(formattedSessionConfigJSON, &sessionConfigStruct)
-	if err != nil {
-		return nil, 0, fmt.Errorf(
-			"failed to unmarshal session config after formatting: %w",
-			err,
-		)
-	}
-
-	_, err = client.VerifyConfig(
-		context.Background(),
-		&pb.VerifyConfigRequest{
-			NumberOfQuestions: int32(numberOfQuestions),
-			SessionConfig:     &sessionConfigStruct,
-		},
-	)
+	})
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok {
 			switch st.Code() {
 			case codes.InvalidArgument:
-				return nil, 0, fmt.Errorf("invalid session config: %s", st.Message())
+				return nil, 0, questions.MarkingOptions{}, fmt.Errorf("invalid session config: %s", st.Message())
 
 			default:
-				return nil, 0, fmt.Errorf(
+				return nil, 0, questions.MarkingOptions{}, fmt.Errorf(
 					"grpc error (%s): %s",
 					st.Code(),
 					st.Message(),
@@ -131,29 +101,28 @@ func postSessionConfig(rawSessionConfig string, client pb.VocabTesterServiceClie
 			}
 		}
 
-		return nil, 0, fmt.Errorf("non-grpc error: %w", err)
+		return nil, 0, questions.MarkingOptions{}, fmt.Errorf("non-grpc error: %w", err)
 	}
 
-	return &sessionConfigStruct, numberOfQuestions, nil
+	return sessionConfigStruct, numberOfQuestions, marking, nil
 }
 
-func postListConfigCmd(vocabList, rawSessionConfig string, serverPort int) tea.Cmd {
+func postListConfigCmd(vocabList, rawSessionConfig string, server app.ServerOptions) tea.Cmd {
 	return func() tea.Msg {
-		serverURL := fmt.Sprintf(
-			"localhost:%d",
-			serverPort,
-		)
-
-		conn, err := grpc.NewClient(serverURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		conn, err := server.Dial()
 		if err != nil {
 			return app.ErrMsg(fmt.Errorf(
 				"failed to create grpc client for url %s: %w",
-				serverURL,
+				server.Address(),
 				err,
 			))
 		}
 		defer conn.Close()
 
+		if err := server.CheckHealth(conn); err != nil {
+			return app.ErrMsg(err)
+		}
+
 		client := pb.NewVocabTesterServiceClient(conn)
 
 		vocabList, err := postVocabList(vocabList, client)
@@ -161,7 +130,7 @@ func postListConfigCmd(vocabList, rawSessionConfig string, serverPort int) tea.C
 			return app.ErrMsg(err)
 		}
 
-		sessionConfig, numberOfQuestions, err := postSessionConfig(rawSessionConfig, client)
+		sessionConfig, numberOfQuestions, marking, err := postSessionConfig(rawSessionConfig, client)
 		if err != nil {
 			return app.ErrMsg(err)
 		}
@@ -170,6 +139,7 @@ func postListConfigCmd(vocabList, rawSessionConfig string, serverPort int) tea.C
 			VocabList:         vocabList,
 			SessionConfig:     sessionConfig,
 			NumberOfQuestions: numberOfQuestions,
+			Marking:           marking,
 		}
 	}
 }
@@ -0,0 +1,123 @@
+package create
+
+import (
+	"encoding/json/v2"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create/config"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+)
+
+// ParseSessionConfig parses a session config, as generated by the config form, into the form expected by the server
+// along with the number of questions it asks for and how answers should be marked. The require-macrons,
+// lenient-articles and progressive-reveal keys are only used by the client, so they are optional and default to false.
+func ParseSessionConfig(rawSessionConfig []byte) (*pb.SessionConfig, int, questions.MarkingOptions, error) {
+	var (
+		mapSessionConfig  map[string]any
+		numberOfQuestions int
+		marking           questions.MarkingOptions
+	)
+
+	err := json.Unmarshal(rawSessionConfig, &mapSessionConfig)
+	if err != nil {
+		return nil, 0, questions.MarkingOptions{}, fmt.Errorf(
+			"failed to unmarshal session config: %w", err,
+		)
+	}
+
+	if x, ok := mapSessionConfig["number-of-questions"]; ok {
+		var y float64
+		if y, ok = x.(float64); !ok {
+			return nil, 0, questions.MarkingOptions{}, errors.New(
+				"session config does not contain number-of-questions (did not get integer)",
+			)
+		}
+
+		numberOfQuestions = int(y)
+
+		delete(mapSessionConfig, "number-of-questions")
+	} else {
+		return nil, 0, questions.MarkingOptions{}, errors.New("session config does not contain number-of-questions")
+	}
+
+	for key, setting := range map[string]*bool{
+		"require-macrons":    &marking.RequireMacrons,
+		"lenient-articles":   &marking.LenientArticles,
+		"progressive-reveal": &marking.ProgressiveReveal,
+	} {
+		x, ok := mapSessionConfig[key]
+		if !ok {
+			continue
+		}
+
+		if *setting, ok = x.(bool); !ok {
+			return nil, 0, questions.MarkingOptions{}, fmt.Errorf(
+				"session config contains invalid %s (did not get boolean)",
+				key,
+			)
+		}
+
+		delete(mapSessionConfig, key)
+	}
+
+	formattedSessionConfig := make(map[string]any)
+	for k, v := range mapSessionConfig {
+		formattedSessionConfig[strings.ReplaceAll(k, "-", "_")] = v
+	}
+
+	formattedSessionConfigJSON, err := json.Marshal(formattedSessionConfig)
+	if err != nil {
+		return nil, 0, questions.MarkingOptions{}, fmt.Errorf(
+			"failed to marshal session config after formatting: %w",
+			err,
+		)
+	}
+
+	var sessionConfigStruct pb.SessionConfig
+
+	err = json.Unmarshal(formattedSessionConfigJSON, &sessionConfigStruct)
+	if err != nil {
+		return nil, 0, questions.MarkingOptions{}, fmt.Errorf(
+			"failed to unmarshal session config after formatting: %w",
+			err,
+		)
+	}
+
+	return &sessionConfigStruct, numberOfQuestions, marking, nil
+}
+
+// ValidateSessionConfig checks that a session config can be used to create a session, returning an error describing
+// each problem with it. This only catches problems that can be found without the server, e.g. not whether the config
+// excludes every word in a particular vocab list.
+func ValidateSessionConfig(sessionConfig *pb.SessionConfig, numberOfQuestions int) []error {
+	var errs []error
+
+	if numberOfQuestions <= 0 {
+		errs = append(errs, fmt.Errorf("number-of-questions must be positive, got %d", numberOfQuestions))
+	}
+
+	if n := sessionConfig.GetNumberMultiplechoiceOptions(); n < config.MinMultipleChoiceOptions ||
+		n > config.MaxMultipleChoiceOptions {
+		errs = append(errs, fmt.Errorf(
+			"number-multiplechoice-options must be between %d and %d, got %d",
+			config.MinMultipleChoiceOptions,
+			config.MaxMultipleChoiceOptions,
+			n,
+		))
+	}
+
+	if !sessionConfig.GetIncludeTypeinEngtolat() &&
+		!sessionConfig.GetIncludeTypeinLattoeng() &&
+		!sessionConfig.GetIncludeParse() &&
+		!sessionConfig.GetIncludeInflect() &&
+		!sessionConfig.GetIncludePrincipalParts() &&
+		!sessionConfig.GetIncludeMultiplechoiceEngtolat() &&
+		!sessionConfig.GetIncludeMultiplechoiceLattoeng() {
+		errs = append(errs, errors.New("no question types are included: set at least one include-* key to true"))
+	}
+
+	return errs
+}
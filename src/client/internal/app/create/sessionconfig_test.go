@@ -0,0 +1,44 @@
+package create
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSessionConfigMultipleChoiceOptions(t *testing.T) {
+	tests := map[string]struct {
+		options int
+		wantErr bool
+	}{
+		"0":   {options: 0, wantErr: true},
+		"1":   {options: 1, wantErr: true},
+		"999": {options: 999, wantErr: true},
+		"4":   {options: 4, wantErr: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sessionConfig, numberOfQuestions, _, err := ParseSessionConfig(fmt.Appendf(nil, `{
+				"number-of-questions": 10,
+				"number-multiplechoice-options": %d,
+				"include-multiplechoice-engtolat": true
+			}`, tt.options))
+			require.NoError(t, err)
+
+			errs := ValidateSessionConfig(sessionConfig, numberOfQuestions)
+			if tt.wantErr {
+				require.Len(t, errs, 1)
+				assert.EqualError(
+					t,
+					errs[0],
+					fmt.Sprintf("number-multiplechoice-options must be between 2 and 10, got %d", tt.options),
+				)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
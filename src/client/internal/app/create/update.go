@@ -19,7 +19,7 @@ func (m *Model) Update(msg tea.Msg) (app.PageModel, tea.Cmd) {
 			return m, postListConfigCmd(
 				m.listtui.VocabEditor.GetCurrentContent(),
 				m.configtui.RawSessionConfig,
-				m.serverPort,
+				m.server,
 			)
 		}
 
@@ -13,8 +13,8 @@ func (m *Model) SetHeight(height int) {
 }
 
 func (m *Model) HasOverlay() bool {
-	return m.configtui.FilepickerActive || m.listtui.FilepickerActive || m.listtui.SaveAsActive ||
-		m.listtui.ModeDropdownActive
+	return m.configtui.FilepickerActive || m.configtui.SaveAsActive || m.listtui.FilepickerActive ||
+		m.listtui.SaveAsActive || m.listtui.ModeDropdownActive
 }
 
 func (m *Model) OverlayView(width, height int) (view string, x, y int) {
@@ -24,6 +24,11 @@ func (m *Model) OverlayView(width, height int) (view string, x, y int) {
 		m.configtui.Filepicker.SetHeight(height / 2)
 		return m.configtui.Filepicker.View(width, height)
 
+	case m.configtui.SaveAsActive:
+		m.configtui.SaveAs.SetWidth(width / 2)
+		m.configtui.SaveAs.SetHeight(height / 2)
+		return m.configtui.SaveAs.View(width, height)
+
 	case m.listtui.FilepickerActive:
 		m.listtui.Filepicker.SetWidth(width / 2)
 		m.listtui.Filepicker.SetHeight(height / 2)
@@ -13,6 +13,7 @@ import (
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/review"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/root/pages"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/settings"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/components/errordialog"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/components/navigator"
@@ -47,6 +48,7 @@ type Model struct {
 	vocabList             string
 	sessionConfig         *pb.SessionConfig
 	numberOfQuestions     int
+	marking               questions.MarkingOptions
 	err                   error
 }
 
@@ -61,7 +63,12 @@ func toStringers[T fmt.Stringer](items []T) []fmt.Stringer {
 
 // TODO: make method currentPageModel() returning m.pages[m.pageOrder[m.currentPage]]
 
-func New(inbuiltListDir string, serverPort int) *Model {
+func New(
+	inbuiltListDir string,
+	createOptions create.Options,
+	server app.ServerOptions,
+	sessionOptions session.Options,
+) *Model {
 	pageOrder := []pages.PageName{
 		pages.Create,
 		pages.Review,
@@ -90,16 +97,18 @@ func New(inbuiltListDir string, serverPort int) *Model {
 	h := help.New()
 	overlayHelp := help.New()
 
-	createtui := create.New(inbuiltListDir, serverPort, &m.styles)
+	createtui := create.New(inbuiltListDir, createOptions, server, &m.styles)
 	reviewtui := review.New(&m.styles)
 
 	sessiontui := session.New(
 		&createtui.VerifySection.ListStatus,
 		&createtui.VerifySection.ConfigStatus,
-		serverPort,
+		server,
+		sessionOptions,
 		&m.vocabList,
 		&m.sessionConfig,
 		&m.numberOfQuestions,
+		&m.marking,
 		&m.styles,
 	)
 
@@ -125,3 +134,8 @@ func New(inbuiltListDir string, serverPort int) *Model {
 
 	return m
 }
+
+// SessionSummary returns a summary of the current testing session, or an empty string if none was started.
+func (m *Model) SessionSummary() string {
+	return m.pages[pages.Test].(*session.Model).Summary()
+}
@@ -31,6 +31,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyPressMsg:
 		// Applied to all pages of the TUI
 		if key.Matches(msg, m.keys.Quit) {
+			if qc, ok := m.pages[m.pageOrder[m.currentPage]].(app.QuitConfirmer); ok && qc.ConfirmQuit() {
+				return m, nil
+			}
+
 			return m, tea.Quit
 		}
 
@@ -136,6 +140,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.vocabList = msg.VocabList
 		m.sessionConfig = msg.SessionConfig
 		m.numberOfQuestions = msg.NumberOfQuestions
+		m.marking = msg.Marking
 
 	case app.ErrMsg:
 		m.err = msg
@@ -0,0 +1,143 @@
+package app
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// retryDelays are how long to wait before each retry when the server is unavailable, e.g. because it is still
+// starting up.
+var retryDelays = []time.Duration{200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond}
+
+// healthCheckTimeout is how long to wait for the server to respond to a health check, including retries.
+const healthCheckTimeout = 2 * time.Second
+
+// transientCodes are the status codes of errors that may go away if the request is retried.
+var transientCodes = []codes.Code{codes.Unavailable, codes.Aborted, codes.ResourceExhausted}
+
+// compressThreshold is the size in bytes above which a vocab list is compressed before it is sent to the server.
+// Smaller lists aren't worth the overhead.
+const compressThreshold = 4 << 10
+
+// ServerOptions describes how to connect to the vocab-tuister server.
+type ServerOptions struct {
+	Host string
+	Port int
+
+	// UseTLS is whether to connect to the server over TLS (the https scheme) rather than plaintext.
+	UseTLS bool
+
+	// InsecureSkipVerify disables verification of the server's certificate, e.g. for self-signed certificates.
+	// This is only used if UseTLS is set.
+	InsecureSkipVerify bool
+}
+
+// Address returns the address of the server, in the form "host:port".
+func (o ServerOptions) Address() string {
+	return net.JoinHostPort(o.Host, strconv.Itoa(o.Port))
+}
+
+// Local reports whether the server is on this machine, i.e. its host is localhost or a loopback address. Only a local
+// server can be the one started alongside the TUI.
+func (o ServerOptions) Local() bool {
+	if strings.EqualFold(o.Host, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(o.Host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// Dial creates a gRPC client connection to the server.
+func (o ServerOptions) Dial() (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if o.UseTLS {
+		creds = credentials.NewTLS(&tls.Config{
+			InsecureSkipVerify: o.InsecureSkipVerify, //nolint:gosec // only if explicitly requested
+		})
+	}
+
+	return grpc.NewClient(o.Address(), grpc.WithTransportCredentials(creds))
+}
+
+// CheckHealth checks that the server is reachable and serving over conn, so that a clear error can be given if it is
+// not. Servers without the health service are assumed to be serving.
+func (o ServerOptions) CheckHealth(conn *grpc.ClientConn) error {
+	client := healthpb.NewHealthClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	resp, err := Retry(ctx, func(ctx context.Context) (*healthpb.HealthCheckResponse, error) {
+		return client.Check(ctx, &healthpb.HealthCheckRequest{})
+	})
+
+	switch status.Code(err) {
+	case codes.OK:
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("vocab-tuister server at %s is not serving (status %s)", o.Address(), resp.GetStatus())
+		}
+
+		return nil
+
+	case codes.Unimplemented:
+		return nil
+
+	case codes.Unavailable:
+		// keep the original error (e.g. connection refused) for debugging
+		return fmt.Errorf("could not reach vocab-tuister server at %s, is it running? (%w)", o.Address(), err)
+
+	case codes.DeadlineExceeded:
+		return fmt.Errorf("timed out waiting for vocab-tuister server at %s to respond", o.Address())
+
+	default:
+		return fmt.Errorf("failed to check health of server at %s: %w", o.Address(), err)
+	}
+}
+
+// VocabCallOptions returns the call options to use for a request that sends vocabList to the server, which compress
+// the request with gzip if vocabList is large.
+func VocabCallOptions(vocabList string) []grpc.CallOption {
+	if len(vocabList) <= compressThreshold {
+		return nil
+	}
+
+	return []grpc.CallOption{grpc.UseCompressor(gzip.Name)}
+}
+
+// Retry calls f, retrying with exponential backoff if it fails with a transient error, e.g. because the server is
+// unavailable. Any other error is returned straight away, as is the last error once ctx is done.
+func Retry[T any](ctx context.Context, f func(ctx context.Context) (T, error)) (T, error) {
+	res, err := f(ctx)
+	for _, delay := range retryDelays {
+		if !slices.Contains(transientCodes, status.Code(err)) || ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return res, err
+
+		case <-time.After(delay):
+		}
+
+		res, err = f(ctx)
+	}
+
+	return res, err
+}
@@ -0,0 +1,255 @@
+package app
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+)
+
+// noRetryDelays removes the delays between retries for the duration of a test, keeping the number of retries.
+func noRetryDelays(t *testing.T) {
+	t.Helper()
+
+	old := retryDelays
+	retryDelays = make([]time.Duration, len(old))
+
+	t.Cleanup(func() { retryDelays = old })
+}
+
+func TestRetry(t *testing.T) {
+	noRetryDelays(t)
+
+	tests := map[string]struct {
+		errs         []error
+		wantAttempts int
+		wantCode     codes.Code
+	}{
+		"Success": {errs: []error{nil}, wantAttempts: 1, wantCode: codes.OK},
+		"InvalidArgument": {
+			errs:         []error{status.Error(codes.InvalidArgument, "")},
+			wantAttempts: 1,
+			wantCode:     codes.InvalidArgument,
+		},
+		"UnavailableOnce": {
+			errs:         []error{status.Error(codes.Unavailable, ""), nil},
+			wantAttempts: 2,
+			wantCode:     codes.OK,
+		},
+		"TransientTwice": {
+			errs:         []error{status.Error(codes.Unavailable, ""), status.Error(codes.Aborted, ""), nil},
+			wantAttempts: 3,
+			wantCode:     codes.OK,
+		},
+		"AlwaysUnavailable": {
+			errs:         []error{status.Error(codes.Unavailable, "")},
+			wantAttempts: 4,
+			wantCode:     codes.Unavailable,
+		},
+		"UnavailableThenInternal": {
+			errs:         []error{status.Error(codes.Unavailable, ""), status.Error(codes.Internal, "")},
+			wantAttempts: 2,
+			wantCode:     codes.Internal,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+
+			_, err := Retry(context.Background(), func(context.Context) (struct{}, error) {
+				err := tt.errs[min(attempts, len(tt.errs)-1)]
+				attempts++
+
+				return struct{}{}, err
+			})
+
+			assert.Equalf(t, tt.wantAttempts, attempts, "expected %d attempts, got %d", tt.wantAttempts, attempts)
+			assert.Equalf(t, tt.wantCode, status.Code(err), "expected code %s, got %s", tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestRetryContextDone(t *testing.T) {
+	noRetryDelays(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts := 0
+
+	_, err := Retry(ctx, func(context.Context) (struct{}, error) {
+		attempts++
+		return struct{}{}, status.Error(codes.Unavailable, "")
+	})
+
+	assert.Equalf(t, 1, attempts, "expected 1 attempt, got %d", attempts)
+	assert.Equalf(t, codes.Unavailable, status.Code(err), "expected code %s, got %s", codes.Unavailable, status.Code(err))
+}
+
+// startHealthServer starts a gRPC server with only the health service, reporting the given status.
+func startHealthServer(t *testing.T, servingStatus healthpb.HealthCheckResponse_ServingStatus) ServerOptions {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", servingStatus)
+
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+
+	go func() { _ = s.Serve(lis) }()
+
+	t.Cleanup(s.Stop)
+
+	return ServerOptions{Host: "127.0.0.1", Port: lis.Addr().(*net.TCPAddr).Port}
+}
+
+func TestCheckHealth(t *testing.T) {
+	tests := map[string]struct {
+		servingStatus healthpb.HealthCheckResponse_ServingStatus
+		wantErr       bool
+	}{
+		"Serving":    {servingStatus: healthpb.HealthCheckResponse_SERVING, wantErr: false},
+		"NotServing": {servingStatus: healthpb.HealthCheckResponse_NOT_SERVING, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := startHealthServer(t, tt.servingStatus)
+
+			conn, err := server.Dial()
+			require.NoError(t, err)
+
+			defer conn.Close()
+
+			err = server.CheckHealth(conn)
+			if tt.wantErr {
+				assert.Errorf(t, err, "expected an error for status %s", tt.servingStatus)
+			} else {
+				assert.NoErrorf(t, err, "expected no error for status %s", tt.servingStatus)
+			}
+		})
+	}
+}
+
+func TestCheckHealthUnreachable(t *testing.T) {
+	noRetryDelays(t)
+
+	// find a port with nothing listening on it
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := ServerOptions{Host: "127.0.0.1", Port: lis.Addr().(*net.TCPAddr).Port}
+	require.NoError(t, lis.Close())
+
+	conn, err := server.Dial()
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	err = server.CheckHealth(conn)
+	assert.ErrorContainsf(t, err, "is it running?", "expected an unreachable server error, got %v", err)
+}
+
+// vocabServer records the vocab lists sent to it, and whether they were compressed.
+type vocabServer struct {
+	pb.UnimplementedVocabTesterServiceServer
+
+	vocabText   string
+	compression string
+}
+
+func (s *vocabServer) VerifyVocab(_ context.Context, req *pb.VerifyVocabRequest) (*pb.VerifyVocabResponse, error) {
+	s.vocabText = req.GetVocabText()
+	return &pb.VerifyVocabResponse{}, nil
+}
+
+func (s *vocabServer) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context { return ctx }
+
+func (s *vocabServer) HandleRPC(_ context.Context, rs stats.RPCStats) {
+	if h, ok := rs.(*stats.InHeader); ok {
+		s.compression = h.Compression
+	}
+}
+
+func (s *vocabServer) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context { return ctx }
+
+func (s *vocabServer) HandleConn(context.Context, stats.ConnStats) {}
+
+func TestVocabCallOptions(t *testing.T) {
+	tests := map[string]struct {
+		vocabText       string
+		wantCompression string
+	}{
+		"Small": {vocabText: "@ Nouns\nboy: puer, pueri, (m)\n", wantCompression: ""},
+		"Large": {vocabText: "@ Nouns\n" + strings.Repeat("boy: puer, pueri, (m)\n", 500), wantCompression: "gzip"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+
+			vs := &vocabServer{}
+			s := grpc.NewServer(grpc.StatsHandler(vs))
+			pb.RegisterVocabTesterServiceServer(s, vs)
+
+			go func() { _ = s.Serve(lis) }()
+
+			t.Cleanup(s.Stop)
+
+			server := ServerOptions{Host: "127.0.0.1", Port: lis.Addr().(*net.TCPAddr).Port}
+
+			conn, err := server.Dial()
+			require.NoError(t, err)
+
+			defer conn.Close()
+
+			_, err = pb.NewVocabTesterServiceClient(conn).VerifyVocab(
+				context.Background(),
+				&pb.VerifyVocabRequest{VocabText: tt.vocabText},
+				VocabCallOptions(tt.vocabText)...,
+			)
+			require.NoError(t, err)
+
+			// the list should arrive intact whether or not it was compressed
+			assert.Equal(t, tt.vocabText, vs.vocabText)
+			assert.Equal(t, tt.wantCompression, vs.compression)
+		})
+	}
+}
+
+func TestServerOptionsLocal(t *testing.T) {
+	tests := map[string]bool{
+		"localhost":         true,
+		"LOCALHOST":         true,
+		"127.0.0.1":         true,
+		"127.0.1.1":         true,
+		"::1":               true,
+		"192.168.1.20":      false,
+		"vocab.example.com": false,
+		"localhost.example": false,
+		"2001:db8::1":       false,
+	}
+
+	for host, want := range tests {
+		t.Run(host, func(t *testing.T) {
+			assert.Equal(t, want, ServerOptions{Host: host}.Local())
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package session
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json/v2"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/util/appdir"
+)
+
+// checkpoint is a snapshot of a session in progress, saved after each question so that the session can be resumed if
+// it is interrupted.
+type checkpoint struct {
+	QuestionsDone int                    `json:"questions-done"` // number of questions answered or skipped
+	Total         int                    `json:"total"`
+	Score         float64                `json:"score"`
+	SkippedCount  int                    `json:"skipped-count"`
+	ElapsedMillis int64                  `json:"elapsed-ms"`
+	History       []checkpointedQuestion `json:"history"`
+}
+
+// checkpointedQuestion is an answered question, as saved in a checkpoint.
+type checkpointedQuestion struct {
+	Prompt   string                 `json:"prompt"`
+	Response string                 `json:"response"`
+	Answer   string                 `json:"answer"`
+	Correct  bool                   `json:"correct"`
+	Hinted   bool                   `json:"hinted"`
+	GaveUp   bool                   `json:"gave-up"`
+	Mode     questions.QuestionMode `json:"mode"`
+}
+
+// countCorrect returns the number of questions in a checkpoint that were answered correctly.
+func countCorrect(history []checkpointedQuestion) int {
+	count := 0
+
+	for _, q := range history {
+		if q.Correct {
+			count++
+		}
+	}
+
+	return count
+}
+
+type checkpointLoadedMsg struct {
+	checkpoint *checkpoint // nil if there is no checkpoint to resume from
+}
+
+// checkpointPath returns the path of the checkpoint for a session with the given inputs, so that a session is only
+// offered to be resumed when it is started again with the same list and config.
+func checkpointPath(vocabList string, sessionConfig *pb.SessionConfig, numberOfQuestions int) string {
+	h := sha256.New()
+	h.Write([]byte(vocabList))
+
+	if b, err := (proto.MarshalOptions{Deterministic: true}).Marshal(sessionConfig); err == nil {
+		h.Write(b)
+	}
+
+	h.Write([]byte(strconv.Itoa(numberOfQuestions)))
+
+	return filepath.Join(
+		appdir.AppDirs.UserCache(),
+		"checkpoints",
+		hex.EncodeToString(h.Sum(nil))[:16]+".json",
+	)
+}
+
+// newCheckpoint creates a checkpoint of the current state of the session.
+func (m *Model) newCheckpoint() *checkpoint {
+	history := make([]checkpointedQuestion, len(m.history))
+	for i, q := range m.history {
+		history[i] = checkpointedQuestion{
+			Prompt:   q.prompt,
+			Response: q.response,
+			Answer:   q.answer,
+			Correct:  q.correct,
+			Hinted:   q.hinted,
+			GaveUp:   q.gaveUp,
+			Mode:     q.mode,
+		}
+	}
+
+	return &checkpoint{
+		QuestionsDone: m.questionProvider.Current(),
+		Total:         m.questionProvider.Total(),
+		Score:         m.score,
+		SkippedCount:  m.skippedCount,
+		ElapsedMillis: time.Since(m.sessionStart).Milliseconds(),
+		History:       history,
+	}
+}
+
+// restoreCheckpoint restores the state of a session from a checkpoint. The questions themselves are not saved, so
+// questions restored this way can be reviewed but not retried.
+func (m *Model) restoreCheckpoint(cp *checkpoint) {
+	for _, q := range cp.History {
+		m.history = append(m.history, answeredQuestion{
+			prompt:   q.Prompt,
+			response: q.Response,
+			answer:   q.Answer,
+			correct:  q.Correct,
+			hinted:   q.Hinted,
+			gaveUp:   q.GaveUp,
+			mode:     q.Mode,
+		})
+
+		m.answeredCount++
+		score := m.scoreByMode[q.Mode]
+		score.total++
+
+		if q.Correct {
+			m.correctCount++
+			score.correct++
+			m.streak++
+			m.bestStreak = max(m.bestStreak, m.streak)
+		} else {
+			m.streak = 0
+		}
+
+		if q.Hinted {
+			m.hintsUsed++
+		}
+
+		if q.GaveUp {
+			m.gaveUpCount++
+		}
+
+		m.scoreByMode[q.Mode] = score
+	}
+
+	m.score = cp.Score
+	m.skippedCount = cp.SkippedCount
+	m.questionOffset = cp.QuestionsDone
+	m.resumedElapsed = time.Duration(cp.ElapsedMillis) * time.Millisecond
+}
+
+func loadCheckpoint(filePath string) tea.Cmd {
+	return func() tea.Msg {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return checkpointLoadedMsg{}
+			}
+
+			return app.ErrMsg(fmt.Errorf("failed to read session checkpoint from %s: %w", filePath, err))
+		}
+
+		var cp checkpoint
+		if err := json.Unmarshal(data, &cp); err != nil {
+			// a checkpoint that can't be read is of no use, so just start a new session
+			return checkpointLoadedMsg{}
+		}
+
+		return checkpointLoadedMsg{checkpoint: &cp}
+	}
+}
+
+func saveCheckpoint(filePath string, cp *checkpoint) tea.Cmd {
+	return func() tea.Msg {
+		data, err := json.Marshal(cp)
+		if err != nil {
+			return app.ErrMsg(fmt.Errorf("failed to marshal session checkpoint: %w", err))
+		}
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			return app.ErrMsg(fmt.Errorf("failed to create directory for session checkpoint: %w", err))
+		}
+
+		if err := os.WriteFile(filePath, data, 0o644); err != nil {
+			return app.ErrMsg(fmt.Errorf("failed to save session checkpoint to %s: %w", filePath, err))
+		}
+
+		return nil
+	}
+}
+
+func removeCheckpoint(filePath string) tea.Cmd {
+	return func() tea.Msg {
+		if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return app.ErrMsg(fmt.Errorf("failed to remove session checkpoint %s: %w", filePath, err))
+		}
+
+		return nil
+	}
+}
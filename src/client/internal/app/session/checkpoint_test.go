@@ -0,0 +1,77 @@
+package session
+
+import (
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/exp/teatest/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+)
+
+// answeredHistory returns the history of a checkpoint in which questions were answered correctly or not, in order.
+func answeredHistory(correct ...bool) []checkpointedQuestion {
+	history := make([]checkpointedQuestion, len(correct))
+	for i, c := range correct {
+		history[i] = checkpointedQuestion{Prompt: "puer", Answer: "boy", Correct: c, Mode: questions.Regular}
+	}
+
+	return history
+}
+
+func TestRestoreCheckpointStreak(t *testing.T) {
+	tests := map[string]struct {
+		history        []checkpointedQuestion
+		wantStreak     int
+		wantBestStreak int
+	}{
+		"Empty":           {history: nil},
+		"AllCorrect":      {history: answeredHistory(true, true, true), wantStreak: 3, wantBestStreak: 3},
+		"EndsIncorrect":   {history: answeredHistory(true, true, false), wantStreak: 0, wantBestStreak: 2},
+		"LongerLastRun":   {history: answeredHistory(true, false, true, true), wantStreak: 2, wantBestStreak: 2},
+		"LongerFirstRun":  {history: answeredHistory(true, true, true, false, true), wantStreak: 1, wantBestStreak: 3},
+		"NoneCorrect":     {history: answeredHistory(false, false), wantStreak: 0, wantBestStreak: 0},
+		"StartsIncorrect": {history: answeredHistory(false, true), wantStreak: 1, wantBestStreak: 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := &Model{scoreByMode: make(map[questions.QuestionMode]modeScore)}
+			m.restoreCheckpoint(&checkpoint{QuestionsDone: len(tt.history), Total: 10, History: tt.history})
+
+			assert.Equal(t, tt.wantStreak, m.streak)
+			assert.Equal(t, tt.wantBestStreak, m.bestStreak)
+		})
+	}
+}
+
+func TestResumeStreak(t *testing.T) {
+	server := startQuestionServer(t, "127.0.0.1", &questionServer{questions: []*pb.Question{
+		typeInProto("puella", "girl"),
+		typeInProto("servus", "slave"),
+	}})
+
+	m := newServerModel(t, Options{}, server, 4)
+	m.pendingCheckpoint = &checkpoint{QuestionsDone: 2, Total: 4, Score: 2, History: answeredHistory(true, true)}
+
+	// resuming requests the rest of the questions
+	_, cmd := m.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	require.Equal(t, Initialised, m.appStatus)
+	assert.Equal(t, 2, m.streak, "streak not restored from the checkpoint")
+
+	m = runSession(t, m, func(tm *teatest.TestModel) {
+		waitForOutput(t, tm, "puella")
+		answerTypeIn(tm, "girl")
+		answerTypeIn(tm, "slave")
+		waitForOutput(t, tm, "Best streak: 4")
+	})
+
+	assert.Equal(t, Completed, m.appStatus)
+	assert.Equal(t, 4, m.streak)
+	assert.Equal(t, 4, m.bestStreak)
+}
@@ -2,21 +2,34 @@ package session
 
 import (
 	"context"
+	"encoding/json/jsontext"
+	"encoding/json/v2"
 	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
+	"os"
 
 	tea "charm.land/bubbletea/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
 	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
 )
 
+// errQuestionsExhausted is returned by [QuestionProvider.Next] if there are fewer questions available than were
+// expected. From then on, [QuestionProvider.Total] is the number of questions that were actually provided.
+var errQuestionsExhausted = errors.New("no more questions are available")
+
+// errNoQuestions is returned if the server does not generate any questions at all.
+var errNoQuestions = errors.New(
+	"no questions were generated: check that the vocab list has words that the session config does not exclude",
+)
+
 type QuestionProvider interface {
 	// Next returns the next question (as a [questions.Question]), handling errors.
 	Next() (questions.Question, error)
@@ -24,6 +37,9 @@ type QuestionProvider interface {
 	// Current returns the number of the current question (counting from 1).
 	Current() int
 
+	// Total returns the total number of questions that will be provided.
+	Total() int
+
 	// Close cleans up the underlying connection. Call this when the session ends.
 	Close() error
 }
@@ -39,11 +55,9 @@ func (p *StreamQuestionProvider) Next() (questions.Question, error) {
 	q, err := p.stream.Recv()
 	if err != nil {
 		if errors.Is(err, io.EOF) {
-			return nil, fmt.Errorf(
-				"stream ended unexpectedly: expected %d questions, got %d",
-				p.total,
-				p.received,
-			)
+			// the server ends the stream early if the vocab list can't fill the number of questions requested
+			p.total = p.received
+			return nil, errQuestionsExhausted
 		}
 
 		st, ok := status.FromError(err)
@@ -55,6 +69,9 @@ func (p *StreamQuestionProvider) Next() (questions.Question, error) {
 			case codes.Internal:
 				return nil, fmt.Errorf("internal error: %s", st.Message())
 
+			case codes.Unavailable:
+				return nil, fmt.Errorf("lost connection to vocab-tuister server: %w", err)
+
 			default:
 				return nil, fmt.Errorf("grpc error (%s): %s", st.Code(), st.Message())
 			}
@@ -70,73 +87,241 @@ func (p *StreamQuestionProvider) Next() (questions.Question, error) {
 
 func (p *StreamQuestionProvider) Current() int { return p.received }
 
+func (p *StreamQuestionProvider) Total() int { return p.total }
+
 func (p *StreamQuestionProvider) Close() error {
 	return p.conn.Close()
 }
 
+// SliceQuestionProvider provides questions that have already been received, e.g. to retry them.
+type SliceQuestionProvider struct {
+	questions questions.Questions
+	current   int
+}
+
+func (p *SliceQuestionProvider) Next() (questions.Question, error) {
+	if p.current >= len(p.questions) {
+		return nil, fmt.Errorf("no questions left: expected %d questions", len(p.questions))
+	}
+
+	p.current++
+
+	return p.questions[p.current-1], nil
+}
+
+func (p *SliceQuestionProvider) Current() int { return p.current }
+
+func (p *SliceQuestionProvider) Total() int { return len(p.questions) }
+
+func (p *SliceQuestionProvider) Close() error { return nil }
+
+// offsetQuestionProvider numbers the questions of another provider as if some questions had already been provided,
+// e.g. when resuming an interrupted session.
+type offsetQuestionProvider struct {
+	QuestionProvider
+	offset int
+}
+
+func (p *offsetQuestionProvider) Current() int { return p.QuestionProvider.Current() + p.offset }
+
+func (p *offsetQuestionProvider) Total() int { return p.QuestionProvider.Total() + p.offset }
+
 type QuestionStreamGetMsg struct {
 	QuestionProvider QuestionProvider
+	reordered        bool // whether the questions have already been reordered by [reorderQuestions]
+	requested        int  // number of questions requested, if fewer were available, 0 otherwise
+}
+
+// tooFewQuestionsError is returned if the vocab list cannot provide as many questions as were requested, and fewer
+// questions are not allowed.
+func tooFewQuestionsError(requested, available int) error {
+	return fmt.Errorf(
+		"requested %d questions, but the vocab list can only provide %d with this session config "+
+			"(request fewer questions, or allow fewer questions to be asked)",
+		requested,
+		available,
+	)
 }
 
-func getQuestions(serverPort int, vocabList string, sessionConfig *pb.SessionConfig, numberOfQuestions int) tea.Cmd {
+// openQuestionStream connects to the server and requests a session, returning a provider of the questions that
+// are streamed back.
+func openQuestionStream(
+	server app.ServerOptions,
+	vocabList string,
+	sessionConfig *pb.SessionConfig,
+	numberOfQuestions int,
+) (*StreamQuestionProvider, error) {
+	conn, err := server.Dial()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc client for url %s: %w", server.Address(), err)
+	}
+
+	if err := server.CheckHealth(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	client := pb.NewVocabTesterServiceClient(conn)
+
+	stream, err := app.Retry(
+		context.Background(),
+		func(ctx context.Context) (grpc.ServerStreamingClient[pb.CreateSessionResponse], error) {
+			return client.CreateSession(
+				ctx,
+				&pb.CreateSessionRequest{
+					VocabList:         vocabList,
+					SessionConfig:     sessionConfig,
+					NumberOfQuestions: int32(numberOfQuestions),
+				},
+				app.VocabCallOptions(vocabList)...,
+			)
+		},
+	)
+	if err != nil {
+		conn.Close()
+
+		st, ok := status.FromError(err)
+		if ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("invalid input: %s", st.Message())
+
+			default:
+				return nil, fmt.Errorf("grpc error (%s): %s", st.Code(), st.Message())
+			}
+		}
+
+		return nil, fmt.Errorf("non-grpc error: %w", err)
+	}
+
+	return &StreamQuestionProvider{
+		conn:   conn,
+		stream: stream,
+		total:  numberOfQuestions,
+	}, nil
+}
+
+func getQuestions(
+	server app.ServerOptions,
+	vocabList string,
+	sessionConfig *pb.SessionConfig,
+	numberOfQuestions int,
+) tea.Cmd {
 	return func() tea.Msg {
-		serverURL := fmt.Sprintf(
-			"localhost:%d",
-			serverPort,
-		)
+		provider, err := openQuestionStream(server, vocabList, sessionConfig, numberOfQuestions)
+		if err != nil {
+			return app.ErrMsg(err)
+		}
+
+		return QuestionStreamGetMsg{QuestionProvider: provider}
+	}
+}
+
+// LoadQuestionsFile reads questions from a file instead of requesting them from the server. The file should contain
+// a JSON array of questions, in the same form as the questions sent by the server.
+func LoadQuestionsFile(filePath string) (questions.Questions, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read questions file %s: %w", filePath, err)
+	}
+
+	var rawQuestions []jsontext.Value
+	if err := json.Unmarshal(data, &rawQuestions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal questions file %s: %w", filePath, err)
+	}
 
-		conn, err := grpc.NewClient(serverURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if len(rawQuestions) == 0 {
+		return nil, fmt.Errorf("questions file %s contains no questions", filePath)
+	}
+
+	qs := make(questions.Questions, len(rawQuestions))
+	for i, raw := range rawQuestions {
+		var q pb.Question
+		if err := protojson.Unmarshal(raw, &q); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal question %d in %s: %w", i+1, filePath, err)
+		}
+
+		qs[i] = questions.NewQuestion(&q)
+		if qs[i] == nil {
+			return nil, fmt.Errorf("question %d in %s has no question type set", i+1, filePath)
+		}
+	}
+
+	return qs, nil
+}
+
+func readQuestionsFile(filePath string) tea.Cmd {
+	return func() tea.Msg {
+		qs, err := LoadQuestionsFile(filePath)
 		if err != nil {
-			return app.ErrMsg(fmt.Errorf(
-				"failed to create grpc client for url %s: %w",
-				serverURL,
-				err,
-			))
+			return app.ErrMsg(err)
 		}
 
-		client := pb.NewVocabTesterServiceClient(conn)
+		return QuestionStreamGetMsg{QuestionProvider: &SliceQuestionProvider{questions: qs}}
+	}
+}
+
+// collectQuestions receives all of the questions from a provider, then closes it. exhausted is true if the provider
+// ran out of questions before providing as many as requested.
+func collectQuestions(provider QuestionProvider) (qs questions.Questions, requested int, exhausted bool, err error) {
+	defer provider.Close()
+
+	requested = provider.Total()
+	qs = make(questions.Questions, 0, requested)
 
-		stream, err := client.CreateSession(
-			context.Background(),
-			&pb.CreateSessionRequest{
-				VocabList:         vocabList,
-				SessionConfig:     sessionConfig,
-				NumberOfQuestions: int32(numberOfQuestions),
-			},
-		)
+	for provider.Current() < provider.Total() {
+		q, err := provider.Next()
+		if errors.Is(err, errQuestionsExhausted) {
+			exhausted = true
+			break
+		} else if err != nil {
+			return nil, requested, false, err
+		}
+
+		qs = append(qs, q)
+	}
+
+	if len(qs) == 0 {
+		return nil, requested, exhausted, errNoQuestions
+	}
+
+	return qs, requested, exhausted, nil
+}
+
+// reorderQuestions receives all of the questions from a provider, then puts them into the given order (shuffling them
+// with rng if the order is random). If historyPath is not empty, questions on the words missed in the previous session
+// recorded there are then moved to the front and repeated at the end. If the provider runs out of questions early, an
+// error is returned unless allowFewer is true.
+func reorderQuestions(
+	provider QuestionProvider,
+	order questions.Order,
+	rng *rand.Rand,
+	historyPath string,
+	allowFewer bool,
+) tea.Cmd {
+	return func() tea.Msg {
+		qs, requested, exhausted, err := collectQuestions(provider)
 		if err != nil {
-			st, ok := status.FromError(err)
-			if ok {
-				switch st.Code() {
-				case codes.InvalidArgument:
-					conn.Close()
-					return app.ErrMsg(fmt.Errorf(
-						"invalid input: %s",
-						st.Message(),
-					))
-
-				default:
-					conn.Close()
-
-					return app.ErrMsg(fmt.Errorf(
-						"grpc error (%s): %s",
-						st.Code(),
-						st.Message(),
-					))
-				}
-			}
+			return app.ErrMsg(err)
+		}
+
+		if exhausted && !allowFewer {
+			return app.ErrMsg(tooFewQuestionsError(requested, len(qs)))
+		}
 
-			conn.Close()
+		qs.Reorder(order, rng)
 
-			return app.ErrMsg(fmt.Errorf("non-grpc error: %w", err))
+		if historyPath != "" {
+			if qs, err = prioritiseMissed(historyPath, qs); err != nil {
+				return app.ErrMsg(err)
+			}
 		}
 
-		return QuestionStreamGetMsg{
-			QuestionProvider: &StreamQuestionProvider{
-				conn:   conn,
-				stream: stream,
-				total:  numberOfQuestions,
-			},
+		msg := QuestionStreamGetMsg{QuestionProvider: &SliceQuestionProvider{questions: qs}, reordered: true}
+		if exhausted {
+			msg.requested = requested
 		}
+
+		return msg
 	}
 }
@@ -0,0 +1,442 @@
+package session
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/exp/teatest/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questioncomponents"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
+)
+
+// questionServer streams the given questions back for every session that is requested.
+type questionServer struct {
+	pb.UnimplementedVocabTesterServiceServer
+
+	questions []*pb.Question
+}
+
+func (s *questionServer) CreateSession(
+	_ *pb.CreateSessionRequest,
+	stream grpc.ServerStreamingServer[pb.CreateSessionResponse],
+) error {
+	for _, q := range s.questions {
+		if err := stream.Send(&pb.CreateSessionResponse{Question: q}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startQuestionServer starts a mock server for qs listening on host.
+func startQuestionServer(t *testing.T, host string, qs *questionServer) app.ServerOptions {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+	require.NoError(t, err)
+
+	s := grpc.NewServer()
+	pb.RegisterVocabTesterServiceServer(s, qs)
+
+	go func() { _ = s.Serve(lis) }()
+
+	t.Cleanup(s.Stop)
+
+	return app.ServerOptions{Host: host, Port: lis.Addr().(*net.TCPAddr).Port}
+}
+
+// externalHost returns an address of this machine that is not a loopback address, skipping the test if there is none.
+func externalHost(t *testing.T) string {
+	t.Helper()
+
+	addrs, err := net.InterfaceAddrs()
+	require.NoError(t, err)
+
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok && !ipNet.IP.IsLoopback() && ipNet.IP.To4() != nil {
+			return ipNet.IP.String()
+		}
+	}
+
+	t.Skip("no non-loopback address to listen on")
+
+	return ""
+}
+
+func typeInProto(prompt, answer string) *pb.Question {
+	return &pb.Question{Kind: &pb.Question_TypeInLatToEng{TypeInLatToEng: &pb.TypeInLatToEngQuestion{
+		Prompt:     prompt,
+		MainAnswer: answer,
+		Answers:    []string{answer},
+	}}}
+}
+
+func TestFetchQuestionsRemoteHost(t *testing.T) {
+	server := startQuestionServer(t, externalHost(t), &questionServer{questions: []*pb.Question{
+		typeInProto("puer", "boy"),
+		typeInProto("puella", "girl"),
+	}})
+	require.Falsef(t, server.Local(), "expected %s not to be a local host", server.Host)
+
+	qs, err := FetchQuestions(server, "", &pb.SessionConfig{}, 2, false)
+	require.NoError(t, err)
+
+	require.Len(t, qs, 2)
+	assert.Equal(t, "puer", qs[0].GetPrompt())
+	assert.Equal(t, "puella", qs[1].GetPrompt())
+}
+
+func TestFetchQuestionsTooFew(t *testing.T) {
+	tests := map[string]struct {
+		allowFewer bool
+		wantErr    string
+	}{
+		"Strict":  {allowFewer: false, wantErr: "requested 3 questions, but the vocab list can only provide 2"},
+		"Lenient": {allowFewer: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := startQuestionServer(t, "127.0.0.1", &questionServer{questions: []*pb.Question{
+				typeInProto("puer", "boy"),
+				typeInProto("puella", "girl"),
+			}})
+
+			qs, err := FetchQuestions(server, "", &pb.SessionConfig{}, 3, tt.allowFewer)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, qs, 2)
+		})
+	}
+}
+
+func TestReorderQuestionsTooFew(t *testing.T) {
+	tests := map[string]struct {
+		allowFewer bool
+		wantErr    string
+	}{
+		"Strict":  {allowFewer: false, wantErr: "requested 3 questions, but the vocab list can only provide 2"},
+		"Lenient": {allowFewer: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := startQuestionServer(t, "127.0.0.1", &questionServer{questions: []*pb.Question{
+				typeInProto("puer", "boy"),
+				typeInProto("puella", "girl"),
+			}})
+
+			provider, err := openQuestionStream(server, "", &pb.SessionConfig{}, 3)
+			require.NoError(t, err)
+
+			msg := reorderQuestions(provider, questions.OrderServer, nil, "", tt.allowFewer)()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, msg.(app.ErrMsg), tt.wantErr)
+				return
+			}
+
+			require.IsType(t, QuestionStreamGetMsg{}, msg)
+
+			got := msg.(QuestionStreamGetMsg)
+			assert.Equal(t, 3, got.requested)
+			assert.Equal(t, 2, got.QuestionProvider.Total())
+		})
+	}
+}
+
+// newServerModel returns a session that requests numberOfQuestions questions from server, and has not yet been
+// started.
+func newServerModel(t *testing.T, options Options, server app.ServerOptions, numberOfQuestions int) *Model {
+	t.Helper()
+
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	status := create.StatusVerified
+	marking := questions.MarkingOptions{}
+	vocabList := ""
+	sessionConfig := &pb.SessionConfig{}
+
+	if options.Attempts == 0 {
+		options.Attempts = 1
+	}
+
+	options.StaticSpinner = true
+	options.HideTimers = true
+
+	m := New(&status, &status, server, options, &vocabList, &sessionConfig, &numberOfQuestions, &marking, &s)
+	m.SetWidth(100)
+	m.SetHeight(40)
+
+	// skip loading the checkpoint, so that the session isn't checkpointed
+	m.appStatus = Uninitialised
+
+	return m
+}
+
+// newServerSession returns a session that has requested numberOfQuestions questions from server, as if it had been
+// started from the create page with no checkpoint to resume, along with the command returned once the questions
+// start arriving. The session only starts straight away if the questions are streamed, i.e. if they are not
+// reordered; otherwise the command collects them.
+func newServerSession(
+	t *testing.T,
+	options Options,
+	server app.ServerOptions,
+	numberOfQuestions int,
+) (*Model, tea.Cmd) {
+	t.Helper()
+
+	m := newServerModel(t, options, server, numberOfQuestions)
+	_, cmd := m.Update(getQuestions(server, "", &pb.SessionConfig{}, numberOfQuestions)())
+
+	return m, cmd
+}
+
+func TestCollectQuestions(t *testing.T) {
+	tests := map[string]struct {
+		questions     []*pb.Question
+		requested     int
+		wantPrompts   []string
+		wantExhausted bool
+		wantErr       error
+	}{
+		"Enough": {
+			questions:   []*pb.Question{typeInProto("puer", "boy"), typeInProto("puella", "girl")},
+			requested:   2,
+			wantPrompts: []string{"puer", "puella"},
+		},
+		"Fewer": {
+			questions:     []*pb.Question{typeInProto("puer", "boy"), typeInProto("puella", "girl")},
+			requested:     3,
+			wantPrompts:   []string{"puer", "puella"},
+			wantExhausted: true,
+		},
+		"None": {
+			requested: 3,
+			wantErr:   errNoQuestions,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := startQuestionServer(t, "127.0.0.1", &questionServer{questions: tt.questions})
+
+			provider, err := openQuestionStream(server, "", &pb.SessionConfig{}, tt.requested)
+			require.NoError(t, err)
+
+			qs, requested, exhausted, err := collectQuestions(provider)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantPrompts, prompts(qs))
+			assert.Equal(t, tt.requested, requested)
+			assert.Equal(t, tt.wantExhausted, exhausted)
+			assert.Equal(t, len(tt.wantPrompts), provider.Total())
+		})
+	}
+}
+
+func TestTooFewQuestionsError(t *testing.T) {
+	err := tooFewQuestionsError(50, 10)
+	assert.EqualError(t, err, "requested 50 questions, but the vocab list can only provide 10 with this session "+
+		"config (request fewer questions, or allow fewer questions to be asked)")
+}
+
+func TestSessionFewerQuestions(t *testing.T) {
+	server := startQuestionServer(t, "127.0.0.1", &questionServer{questions: []*pb.Question{
+		typeInProto("puer", "boy"),
+		typeInProto("puella", "girl"),
+	}})
+
+	m, _ := newServerSession(t, Options{AllowFewer: true}, server, 3)
+	require.Equal(t, Initialised, m.appStatus)
+
+	m = runSession(t, m, func(tm *teatest.TestModel) {
+		waitForOutput(t, tm, "puer")
+		answerTypeIn(tm, "boy")
+		waitForOutput(t, tm, "puella")
+		answerTypeIn(tm, "girl")
+		waitForOutput(t, tm, "only 2 were available")
+	})
+
+	assert.Equal(t, Completed, m.appStatus)
+	assert.Equal(t, 2, m.correctCount)
+	assert.Equal(t, 3, m.questionsRequested)
+	assert.Equal(t, 2, m.questionProvider.Total())
+}
+
+func TestSessionTooFewQuestionsStreamed(t *testing.T) {
+	server := startQuestionServer(t, "127.0.0.1", &questionServer{questions: []*pb.Question{
+		typeInProto("puer", "boy"),
+		typeInProto("puella", "girl"),
+	}})
+
+	// the questions are streamed, so the session starts before it is known that there are too few
+	m, _ := newServerSession(t, Options{}, server, 3)
+	require.Equal(t, Initialised, m.appStatus)
+	require.Equal(t, "puer", m.currentQuestion.GetPrompt())
+
+	m.nextQuestion()
+	require.Equal(t, "puella", m.currentQuestion.GetPrompt())
+
+	cmd := m.nextQuestion()
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	require.Implements(t, (*app.ErrMsg)(nil), msg)
+	assert.EqualError(t, msg.(app.ErrMsg), tooFewQuestionsError(3, 2).Error())
+	assert.NotEqual(t, Completed, m.appStatus)
+}
+
+func TestSessionRevealAfter(t *testing.T) {
+	tests := map[string]struct {
+		revealAfter    time.Duration
+		elapsed        time.Duration
+		confirmingQuit bool
+		wantGaveUp     bool
+	}{
+		"NotDue":         {revealAfter: 10 * time.Second, elapsed: 9 * time.Second},
+		"Due":            {revealAfter: 10 * time.Second, elapsed: 10 * time.Second, wantGaveUp: true},
+		"Disabled":       {revealAfter: 0, elapsed: time.Hour},
+		"ConfirmingQuit": {revealAfter: 10 * time.Second, elapsed: time.Hour, confirmingQuit: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := startQuestionServer(t, "127.0.0.1", &questionServer{questions: []*pb.Question{
+				typeInProto("puer", "boy"),
+			}})
+
+			m, _ := newServerSession(t, Options{RevealAfter: tt.revealAfter}, server, 1)
+			require.Equal(t, Initialised, m.appStatus)
+
+			// inject the tick, rather than waiting for the question to time out
+			m.questionStart = time.Now().Add(-tt.elapsed)
+			if tt.confirmingQuit {
+				require.True(t, m.ConfirmQuit())
+			}
+
+			_, cmd := m.Update(timerTickMsg{id: m.timerTickID})
+			require.NotNil(t, cmd)
+
+			if !tt.wantGaveUp {
+				assert.Equal(t, questioncomponents.Unanswered, m.currentQuestionModel.QuestionStatus())
+				assert.Empty(t, m.history)
+
+				return
+			}
+
+			assert.Equal(t, questioncomponents.Incorrect, m.currentQuestionModel.QuestionStatus())
+
+			m.Update(questioncomponents.QuestionAnsweredMsg{GaveUp: true})
+			require.Len(t, m.history, 1)
+			assert.False(t, m.history[0].correct)
+			assert.True(t, m.history[0].gaveUp)
+			assert.Equal(t, 1, m.gaveUpCount)
+		})
+	}
+}
+
+func TestSessionRevealAfterStaleTick(t *testing.T) {
+	server := startQuestionServer(t, "127.0.0.1", &questionServer{questions: []*pb.Question{
+		typeInProto("puer", "boy"),
+	}})
+
+	m, _ := newServerSession(t, Options{RevealAfter: 10 * time.Second}, server, 1)
+	m.questionStart = time.Now().Add(-time.Minute)
+
+	// a tick from an older chain, e.g. one started for the previous question, is ignored
+	_, cmd := m.Update(timerTickMsg{id: m.timerTickID - 1})
+	assert.Nil(t, cmd)
+	assert.Equal(t, questioncomponents.Unanswered, m.currentQuestionModel.QuestionStatus())
+}
+
+func TestSessionRevealAfterCountdown(t *testing.T) {
+	server := startQuestionServer(t, "127.0.0.1", &questionServer{questions: []*pb.Question{
+		typeInProto("puer", "boy"),
+	}})
+
+	m, _ := newServerSession(t, Options{RevealAfter: 10 * time.Second}, server, 1)
+
+	tests := map[time.Duration]string{
+		0:                       "10s left",
+		3500 * time.Millisecond: "7s left",
+		10 * time.Second:        "0s left",
+		time.Minute:             "0s left",
+	}
+
+	for elapsed, want := range tests {
+		m.questionStart = time.Now().Add(-elapsed)
+		assert.Equalf(t, want, m.elapsedText(), "after %s", elapsed)
+	}
+}
+
+func TestSessionNoQuestions(t *testing.T) {
+	tests := map[string]Options{
+		"Collected": {Order: questions.OrderRandom},
+		"Streamed":  {},
+	}
+
+	for name, options := range tests {
+		t.Run(name, func(t *testing.T) {
+			// the server ends the stream without any questions, i.e. it sends back an empty array
+			server := startQuestionServer(t, "127.0.0.1", &questionServer{})
+
+			m, cmd := newServerSession(t, options, server, 3)
+			require.NotNil(t, cmd)
+
+			msg := cmd()
+			require.Implements(t, (*app.ErrMsg)(nil), msg)
+			assert.ErrorIs(t, msg.(app.ErrMsg), errNoQuestions)
+			assert.NotEqual(t, Initialised, m.appStatus)
+		})
+	}
+}
+
+func TestLoadQuestionsFileEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "questions.json")
+	require.NoError(t, os.WriteFile(path, []byte("[]"), 0o600))
+
+	_, err := LoadQuestionsFile(path)
+	assert.ErrorContains(t, err, "contains no questions")
+}
+
+func TestSessionRevealAfterPausedWhileConfirmingQuit(t *testing.T) {
+	server := startQuestionServer(t, "127.0.0.1", &questionServer{questions: []*pb.Question{
+		typeInProto("puer", "boy"),
+	}})
+
+	m, _ := newServerSession(t, Options{RevealAfter: 10 * time.Second}, server, 1)
+
+	// the user is asked to confirm quitting 4 seconds into the question, and takes 5 seconds to decide not to
+	m.questionStart = time.Now().Add(-9 * time.Second)
+	require.True(t, m.ConfirmQuit())
+	m.quitPromptStart = time.Now().Add(-5 * time.Second)
+	assert.Equal(t, "6s left", m.elapsedText())
+
+	m.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	require.False(t, m.confirmingQuit)
+	assert.Equal(t, "6s left", m.elapsedText())
+
+	m.Update(timerTickMsg{id: m.timerTickID})
+	assert.Equal(t, questioncomponents.Unanswered, m.currentQuestionModel.QuestionStatus())
+}
@@ -0,0 +1,145 @@
+package session
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/stats"
+)
+
+// FetchQuestions requests all of the questions for a session from the server, for running a session without the TUI.
+// If the vocab list cannot provide as many questions as were requested, an error is returned unless allowFewer is
+// true.
+func FetchQuestions(
+	server app.ServerOptions,
+	vocabList string,
+	sessionConfig *pb.SessionConfig,
+	numberOfQuestions int,
+	allowFewer bool,
+) (questions.Questions, error) {
+	provider, err := openQuestionStream(server, vocabList, sessionConfig, numberOfQuestions)
+	if err != nil {
+		return nil, err
+	}
+
+	qs, requested, exhausted, err := collectQuestions(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	if exhausted && !allowFewer {
+		return nil, tooFewQuestionsError(requested, len(qs))
+	}
+
+	return qs, nil
+}
+
+// MarshalQuestions returns the questions as a JSON array, in the same form as the questions sent by the server, so
+// that the output can be read back with --questions-file.
+func MarshalQuestions(qs questions.Questions) ([]byte, error) {
+	rawQuestions := make([]jsontext.Value, len(qs))
+	for i, q := range qs {
+		pbQuestion := questions.ToProto(q)
+		if pbQuestion == nil {
+			return nil, fmt.Errorf("question %d (%s) cannot be written as JSON", i+1, q.GetPrompt())
+		}
+
+		raw, err := protojson.Marshal(pbQuestion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal question %d: %w", i+1, err)
+		}
+
+		rawQuestions[i] = raw
+	}
+
+	data, err := json.Marshal(rawQuestions, jsontext.WithIndent("  "))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal questions: %w", err)
+	}
+
+	return data, nil
+}
+
+// parseResponse converts a response from JSON to the form that the question is checked with. Principal parts questions
+// take an array of strings, or a single string of the parts separated by commas.
+func parseResponse(q questions.Question, raw jsontext.Value) (any, error) {
+	var response string
+	if err := json.Unmarshal(raw, &response); err == nil {
+		if _, ok := q.(*questions.PrincipalPartsQuestion); !ok {
+			return response, nil
+		}
+
+		parts := strings.Split(response, ",")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+
+		return parts, nil
+	}
+
+	var parts []string
+	if _, ok := q.(*questions.PrincipalPartsQuestion); !ok || json.Unmarshal(raw, &parts) != nil {
+		return nil, fmt.Errorf(
+			"invalid response %s: must be a string, an array of strings for principal parts questions, or null",
+			raw,
+		)
+	}
+
+	return parts, nil
+}
+
+// Grade marks responses to the questions, as the TUI would, and returns the results in the same form as the results
+// file. The responses are a JSON array with one response for each question: a string, an array of strings for
+// principal parts questions, or null to skip the question. Questions without a response are skipped.
+func Grade(qs questions.Questions, rawResponses []byte, marking questions.MarkingOptions) (*stats.Results, error) {
+	var responses []jsontext.Value
+	if err := json.Unmarshal(rawResponses, &responses); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal responses: %w", err)
+	}
+
+	if len(responses) > len(qs) {
+		return nil, fmt.Errorf("got %d responses, but there are only %d questions", len(responses), len(qs))
+	}
+
+	results := &stats.Results{Skipped: len(qs) - len(responses)}
+
+	for i, raw := range responses {
+		q := qs[i]
+		if raw.Kind() == 'n' {
+			results.Skipped++
+			continue
+		}
+
+		response, err := parseResponse(q, raw)
+		if err != nil {
+			return nil, fmt.Errorf("question %d: %w", i+1, err)
+		}
+
+		correct := questions.CheckResponse(q, response, marking)
+		partsCorrect, total := questions.CheckPartial(q, response, marking)
+
+		results.Answered++
+		results.Score += float64(partsCorrect) / float64(total)
+
+		if correct {
+			results.Correct++
+		}
+
+		results.Questions = append(results.Questions, stats.QuestionResult{
+			Prompt:       q.GetPrompt(),
+			MainAnswer:   formatAnswer(q.GetMainAnswer()),
+			Response:     formatAnswer(response),
+			Correct:      correct,
+			QuestionMode: questionModeName(q.QuestionMode()),
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,176 @@
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/stats"
+)
+
+func principalParts(prompt string, parts ...string) questions.Question {
+	return &questions.PrincipalPartsQuestion{PrincipalPartsQuestion: &pb.PrincipalPartsQuestion{
+		Prompt:         prompt,
+		PrincipalParts: parts,
+	}}
+}
+
+func TestGrade(t *testing.T) {
+	qs := questions.Questions{
+		typeIn("puer", "boy", "the boy"),
+		principalParts("amo", "amo", "amare", "amavi", "amatus"),
+	}
+
+	tests := map[string]struct {
+		responses   string
+		wantScore   float64
+		wantCorrect int
+		wantSkipped int
+		wantMarked  []bool
+	}{
+		"Strings": {
+			responses:   `["the boy", "amo, amare, amavi, amatus"]`,
+			wantScore:   2,
+			wantCorrect: 2,
+			wantMarked:  []bool{true, true},
+		},
+		"Array": {
+			responses:   `["boy", ["amo", "amare", "amavi", "amatus"]]`,
+			wantScore:   2,
+			wantCorrect: 2,
+			wantMarked:  []bool{true, true},
+		},
+		"CommaSplitSpacing": {
+			responses:   `["boy", "amo,amare ,  amavi,amatus"]`,
+			wantScore:   2,
+			wantCorrect: 2,
+			wantMarked:  []bool{true, true},
+		},
+		"PartialScore": {
+			responses:   `["girl", ["amo", "amare", "amavit", "amatum"]]`,
+			wantScore:   0.5,
+			wantCorrect: 0,
+			wantMarked:  []bool{false, false},
+		},
+		"Null": {
+			responses:   `[null, ["amo", "amare", "amavi", "amatus"]]`,
+			wantScore:   1,
+			wantCorrect: 1,
+			wantSkipped: 1,
+			wantMarked:  []bool{true},
+		},
+		"FewerResponses": {
+			responses:   `["boy"]`,
+			wantScore:   1,
+			wantCorrect: 1,
+			wantSkipped: 1,
+			wantMarked:  []bool{true},
+		},
+		"Empty": {
+			responses:   `[]`,
+			wantSkipped: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			results, err := Grade(qs, []byte(tt.responses), questions.MarkingOptions{})
+			require.NoError(t, err)
+
+			assert.InDelta(t, tt.wantScore, results.Score, 1e-9)
+			assert.Equal(t, tt.wantCorrect, results.Correct)
+			assert.Equal(t, tt.wantSkipped, results.Skipped)
+			assert.Equal(t, len(tt.wantMarked), results.Answered)
+
+			var marked []bool
+			for _, q := range results.Questions {
+				marked = append(marked, q.Correct)
+			}
+
+			assert.Equal(t, tt.wantMarked, marked)
+		})
+	}
+}
+
+func TestGradeResults(t *testing.T) {
+	qs := questions.Questions{typeIn("puer", "boy"), principalParts("amo", "amo", "amare", "amavi", "amatus")}
+
+	results, err := Grade(qs, []byte(`["dog", "amo, amare, amavi, amatus"]`), questions.MarkingOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []stats.QuestionResult{
+		{Prompt: "puer", MainAnswer: "boy", Response: "dog", QuestionMode: "Regular"},
+		{
+			Prompt:       "amo",
+			MainAnswer:   "amo, amare, amavi, amatus",
+			Response:     "amo, amare, amavi, amatus",
+			Correct:      true,
+			QuestionMode: "Principal parts",
+		},
+	}, results.Questions)
+}
+
+func TestGradeErrors(t *testing.T) {
+	qs := questions.Questions{typeIn("puer", "boy"), principalParts("amo", "amo", "amare", "amavi", "amatus")}
+
+	tests := map[string]struct {
+		responses string
+		wantErr   string
+	}{
+		"TooManyResponses": {
+			responses: `["boy", "amo, amare, amavi, amatus", "extra"]`,
+			wantErr:   "got 3 responses, but there are only 2 questions",
+		},
+		"NotAnArray":        {responses: `{"puer": "boy"}`, wantErr: "failed to unmarshal responses"},
+		"InvalidJSON":       {responses: `["boy",`, wantErr: "failed to unmarshal responses"},
+		"ArrayForTypeIn":    {responses: `[["boy"]]`, wantErr: "question 1: invalid response"},
+		"NumberForParts":    {responses: `["boy", 4]`, wantErr: "question 2: invalid response"},
+		"NumbersInArray":    {responses: `["boy", [1, 2]]`, wantErr: "question 2: invalid response"},
+		"ObjectForResponse": {responses: `[{}]`, wantErr: "question 1: invalid response"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Grade(qs, []byte(tt.responses), questions.MarkingOptions{})
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestMarshalQuestions(t *testing.T) {
+	tests := map[string]questions.Questions{
+		"TypeIn":         {typeIn("puer", "boy", "the boy")},
+		"PrincipalParts": {principalParts("amo", "amo", "amare", "amavi", "amatus")},
+		"Mixed": {
+			typeIn("puer", "boy"),
+			principalParts("amo", "amo", "amare", "amavi", "amatus"),
+			&questions.MultipleChoiceEngToLatQuestion{
+				MultipleChoiceEngToLatQuestion: &pb.MultipleChoiceEngToLatQuestion{
+					Prompt:  "that",
+					Answer:  "ille",
+					Choices: []string{"audio", "ille", "nomen"},
+				},
+			},
+		},
+	}
+
+	for name, qs := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := MarshalQuestions(qs)
+			require.NoError(t, err)
+
+			// the output can be read back in as a questions file
+			path := filepath.Join(t.TempDir(), "questions.json")
+			require.NoError(t, os.WriteFile(path, data, 0o600))
+
+			got, err := LoadQuestionsFile(path)
+			require.NoError(t, err)
+			assert.Equal(t, qs, got)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package session
+
+import (
+	"strings"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/stats"
+)
+
+// missedRepeats is the number of extra times that a question on a previously missed word is asked, at the end of the
+// session.
+const missedRepeats = 1
+
+// normaliseWord returns s in the form that words are compared in when matching questions to missed ones, so that case
+// and macrons are ignored.
+func normaliseWord(s string) string {
+	return strings.ToLower(questions.NormalizeMacrons(strings.TrimSpace(s)))
+}
+
+// readMissedWords reads a results file saved by a previous session, returning the words that were missed in it: the
+// prompt and main answer of each question that was not answered correctly.
+//
+// Both are used because the same word is rarely asked with the same prompt twice, e.g. "puer" might be asked for in
+// English one session and given in Latin the next.
+func readMissedWords(filePath string) (map[string]bool, error) {
+	results, err := stats.ReadResults(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	missed := make(map[string]bool)
+
+	for _, q := range results.Questions {
+		if !q.Correct {
+			missed[normaliseWord(q.Prompt)] = true
+			missed[normaliseWord(q.MainAnswer)] = true
+		}
+	}
+
+	delete(missed, "")
+
+	return missed, nil
+}
+
+// isMissed reports whether q is on one of the missed words, i.e. whether its prompt or any of its answers were missed.
+func isMissed(q questions.Question, missed map[string]bool) bool {
+	words := append([]string{q.GetPrompt(), formatAnswer(q.GetMainAnswer())}, questions.GetAllAnswers(q)...)
+
+	for _, word := range words {
+		if missed[normaliseWord(word)] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// prioritiseMissed weights questions towards the words that were missed in a previous session (as recorded in the
+// results file at historyPath). Questions on those words are moved to the front, keeping their order, and are asked
+// again at the end of the session.
+func prioritiseMissed(historyPath string, qs questions.Questions) (questions.Questions, error) {
+	missed, err := readMissedWords(historyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var missedQuestions, otherQuestions questions.Questions
+
+	for _, q := range qs {
+		if isMissed(q, missed) {
+			missedQuestions = append(missedQuestions, q)
+		} else {
+			otherQuestions = append(otherQuestions, q)
+		}
+	}
+
+	prioritised := make(questions.Questions, 0, len(qs)+missedRepeats*len(missedQuestions))
+	prioritised = append(prioritised, missedQuestions...)
+	prioritised = append(prioritised, otherQuestions...)
+
+	for range missedRepeats {
+		prioritised = append(prioritised, missedQuestions...)
+	}
+
+	return prioritised, nil
+}
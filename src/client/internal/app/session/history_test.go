@@ -0,0 +1,125 @@
+package session
+
+import (
+	"encoding/json/v2"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/stats"
+)
+
+// writeHistory writes a results file with the given questions, returning its path.
+func writeHistory(t *testing.T, qs ...stats.QuestionResult) string {
+	t.Helper()
+
+	data, err := json.Marshal(stats.Results{Answered: len(qs), Questions: qs})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "results.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	return path
+}
+
+func typeInEngToLat(prompt string, answers ...string) questions.Question {
+	return &questions.TypeInEngToLatQuestion{TypeInEngToLatQuestion: &pb.TypeInEngToLatQuestion{
+		Prompt:     prompt,
+		MainAnswer: answers[0],
+		Answers:    answers,
+	}}
+}
+
+func prompts(qs questions.Questions) []string {
+	ps := make([]string, len(qs))
+	for i, q := range qs {
+		ps[i] = q.GetPrompt()
+	}
+
+	return ps
+}
+
+func TestReadMissedWords(t *testing.T) {
+	path := writeHistory(t,
+		stats.QuestionResult{Prompt: "puer", MainAnswer: "boy", Response: "boy", Correct: true},
+		stats.QuestionResult{Prompt: "Praemium", MainAnswer: "reward", Response: "prize"},
+		stats.QuestionResult{Prompt: "amō", MainAnswer: "amō, amāre, amāvī, amātus", Response: "amo"},
+		stats.QuestionResult{Prompt: "", MainAnswer: "", Response: ""},
+	)
+
+	missed, err := readMissedWords(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]bool{
+		"praemium":                  true,
+		"reward":                    true,
+		"amo":                       true,
+		"amo, amare, amavi, amatus": true,
+	}, missed)
+}
+
+func TestReadMissedWordsInvalid(t *testing.T) {
+	dir := t.TempDir()
+
+	empty := filepath.Join(dir, "empty.json")
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+
+	corrupt := filepath.Join(dir, "corrupt.json")
+	require.NoError(t, os.WriteFile(corrupt, []byte(`{"questions": [{"prompt": `), 0o600))
+
+	tests := map[string]struct {
+		path    string
+		wantErr string
+	}{
+		"Missing": {path: filepath.Join(dir, "missing.json"), wantErr: "failed to read session results"},
+		"Empty":   {path: empty, wantErr: "failed to parse session results"},
+		"Corrupt": {path: corrupt, wantErr: "failed to parse session results"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := readMissedWords(tt.path)
+			assert.ErrorContains(t, err, tt.wantErr)
+
+			_, err = prioritiseMissed(tt.path, questions.Questions{typeIn("puer", "boy")})
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPrioritiseMissed(t *testing.T) {
+	path := writeHistory(t,
+		stats.QuestionResult{Prompt: "puer", MainAnswer: "boy", Correct: true},
+		stats.QuestionResult{Prompt: "servus", MainAnswer: "slave"},
+		stats.QuestionResult{Prompt: "nauta", MainAnswer: "sailor"},
+	)
+
+	qs := questions.Questions{
+		typeIn("puer", "boy"),
+		typeIn("puella", "girl"),
+		typeInEngToLat("slave", "servus"), // asked the other way round this time
+		typeIn("dominus", "master"),
+		typeIn("NAUTA", "sailor"),
+	}
+
+	got, err := prioritiseMissed(path, qs)
+	require.NoError(t, err)
+
+	// missed words first, then the rest in their original order, then the missed words again
+	assert.Equal(t, []string{"slave", "NAUTA", "puer", "puella", "dominus", "slave", "NAUTA"}, prompts(got))
+}
+
+func TestPrioritiseMissedNothingMissed(t *testing.T) {
+	path := writeHistory(t, stats.QuestionResult{Prompt: "puer", MainAnswer: "boy", Correct: true})
+
+	qs := questions.Questions{typeIn("puer", "boy"), typeIn("puella", "girl")}
+
+	got, err := prioritiseMissed(path, qs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"puer", "puella"}, prompts(got))
+}
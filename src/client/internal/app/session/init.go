@@ -9,7 +9,7 @@ import (
 
 func (m *Model) Init() tea.Cmd {
 	if m.appStatus == Initialised {
-		return m.currentQuestionModel.Init()
+		return tea.Batch(m.currentQuestionModel.Init(), m.startTimerTicks())
 	}
 
 	return util.MsgCmd(navigator.AddNavigableMsg{
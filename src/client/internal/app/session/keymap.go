@@ -0,0 +1,29 @@
+package session
+
+import (
+	"encoding/json/v2"
+	"fmt"
+	"os"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questioncomponents"
+)
+
+// LoadKeyOverrides reads the keys to use in a session from a JSON file, which maps action names to lists of keys, e.g.
+// {"Submit": ["ctrl+j"], "Up": ["k"], "Down": ["j"]}.
+func LoadKeyOverrides(filePath string) (questioncomponents.KeyOverrides, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keymap from %s: %w", filePath, err)
+	}
+
+	var overrides questioncomponents.KeyOverrides
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("failed to parse keymap from %s: %w", filePath, err)
+	}
+
+	if err := overrides.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid keymap %s: %w", filePath, err)
+	}
+
+	return overrides, nil
+}
@@ -44,8 +44,28 @@ func (k loadingKeyMap) FullHelp() [][]key.Binding {
 	}
 }
 
+type resumeKeyMap struct {
+	Resume    key.Binding
+	StartOver key.Binding
+	Help      key.Binding
+	Quit      key.Binding
+}
+
+func (k resumeKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Resume, k.StartOver, k.Help, k.Quit}
+}
+
+func (k resumeKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Resume, k.StartOver},
+		{k.Help, k.Quit},
+	}
+}
+
 type completedKeyMap struct {
 	PressButton   key.Binding
+	Retry         key.Binding
+	DeclineRetry  key.Binding
 	PreviousFocus key.Binding
 	NextFocus     key.Binding
 	Help          key.Binding
@@ -53,22 +73,119 @@ type completedKeyMap struct {
 }
 
 func (k completedKeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.PressButton, k.NextFocus, k.Help, k.Quit}
+	return []key.Binding{k.PressButton, k.Retry, k.NextFocus, k.Help, k.Quit}
 }
 
 func (k completedKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.PressButton, k.PreviousFocus, k.NextFocus},
+		{k.PressButton, k.Retry, k.DeclineRetry},
+		{k.PreviousFocus, k.NextFocus},
+		{k.Help, k.Quit},
+	}
+}
+
+type reviewKeyMap struct {
+	Previous key.Binding
+	Next     key.Binding
+	Close    key.Binding
+	Help     key.Binding
+	Quit     key.Binding
+}
+
+func (k reviewKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Previous, k.Next, k.Close, k.Help, k.Quit}
+}
+
+func (k reviewKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Previous, k.Next, k.Close},
 		{k.Help, k.Quit},
 	}
 }
 
+type confirmQuitKeyMap struct {
+	Confirm key.Binding
+	Cancel  key.Binding
+}
+
+func (k confirmQuitKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Confirm, k.Cancel}
+}
+
+func (k confirmQuitKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Confirm, k.Cancel}}
+}
+
+// questionKeyMap adds the bindings for marking the question for later study and looking back at previous questions to
+// the key map of the current question.
+type questionKeyMap struct {
+	help.KeyMap
+	Mark     key.Binding
+	Previous key.Binding
+}
+
+func (k questionKeyMap) ShortHelp() []key.Binding {
+	return append(k.KeyMap.ShortHelp(), k.Mark, k.Previous)
+}
+
+func (k questionKeyMap) FullHelp() [][]key.Binding {
+	return append(k.KeyMap.FullHelp(), []key.Binding{k.Mark, k.Previous})
+}
+
+// newReviewKeyMap creates the key map used when reviewing answered questions, described using noun, with any keys
+// remapped by the user.
+func newReviewKeyMap(noun string, overrides questioncomponents.KeyOverrides) reviewKeyMap {
+	keyMap := reviewKeyMap{
+		Previous: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "previous "+noun),
+		),
+		Next: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "next "+noun),
+		),
+		Close: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "close review"),
+		),
+		Help: key.NewBinding(
+			key.WithKeys("ctrl+h"),
+			key.WithHelp("ctrl+h", "toggle additional help"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("ctrl+q", "ctrl+c"),
+			key.WithHelp("ctrl+q", "quit"),
+		),
+	}
+	overrides.Remap("Up", &keyMap.Previous)
+	overrides.Remap("Down", &keyMap.Next)
+	overrides.Remap("CloseReview", &keyMap.Close)
+
+	return keyMap
+}
+
 func (m *Model) KeyMap() help.KeyMap {
 	if m.dropdownActive {
 		return m.currentQuestionModel.(*questioncomponents.ParseQuestionModel).
 			Dropdowns[m.activeDropdownIndex].KeyMap()
 	}
 
+	if m.confirmingQuit {
+		keyMap := confirmQuitKeyMap{
+			Confirm: key.NewBinding(
+				key.WithKeys("y"),
+				key.WithHelp("y", "quit"),
+			),
+			Cancel: key.NewBinding(
+				key.WithKeys("n"),
+				key.WithHelp("n", "continue session"),
+			),
+		}
+		m.options.KeyOverrides.Remap("ConfirmQuit", &keyMap.Confirm)
+
+		return keyMap
+	}
+
 	switch m.appStatus {
 	case Unavailable:
 		return unavailableKeyMap{
@@ -95,6 +212,31 @@ func (m *Model) KeyMap() help.KeyMap {
 		}
 
 	case Uninitialised:
+		if m.pendingCheckpoint != nil {
+			keyMap := resumeKeyMap{
+				Resume: key.NewBinding(
+					key.WithKeys("y"),
+					key.WithHelp("y", "resume session"),
+				),
+				StartOver: key.NewBinding(
+					key.WithKeys("n"),
+					key.WithHelp("n", "start new session"),
+				),
+				Help: key.NewBinding(
+					key.WithKeys("ctrl+h"),
+					key.WithHelp("ctrl+h", "toggle additional help"),
+				),
+				Quit: key.NewBinding(
+					key.WithKeys("ctrl+q", "ctrl+c"),
+					key.WithHelp("ctrl+q", "quit"),
+				),
+			}
+			m.options.KeyOverrides.Remap("Resume", &keyMap.Resume)
+			m.options.KeyOverrides.Remap("StartOver", &keyMap.StartOver)
+
+			return keyMap
+		}
+
 		return loadingKeyMap{
 			PreviousFocus: key.NewBinding(
 				key.WithKeys("["),
@@ -115,14 +257,51 @@ func (m *Model) KeyMap() help.KeyMap {
 		}
 
 	case Initialised:
-		return m.currentQuestionModel.KeyMap()
+		if m.viewingHistory {
+			return newReviewKeyMap("question", m.options.KeyOverrides)
+		}
+
+		keyMap := questionKeyMap{
+			KeyMap: m.currentQuestionModel.KeyMap(),
+			Mark: key.NewBinding(
+				key.WithKeys("ctrl+r"),
+				key.WithHelp("ctrl+r", "mark for later"),
+			),
+			Previous: key.NewBinding(
+				key.WithKeys("ctrl+p"),
+				key.WithHelp("ctrl+p", "previous question"),
+			),
+		}
+		m.options.KeyOverrides.Remap("Mark", &keyMap.Mark)
+		m.options.KeyOverrides.Remap("PreviousQuestion", &keyMap.Previous)
+
+		if m.currentMarked() {
+			keyMap.Mark.SetHelp(keyMap.Mark.Help().Key, "unmark")
+		}
+
+		// the previous question can only be looked back at once the current one is answered
+		keyMap.Previous.SetEnabled(m.currentQuestionModel.QuestionStatus() != questioncomponents.Unanswered)
+
+		return keyMap
 
 	case Completed:
-		return completedKeyMap{
+		if m.reviewing {
+			return newReviewKeyMap("mistake", m.options.KeyOverrides)
+		}
+
+		keyMap := completedKeyMap{
 			PressButton: key.NewBinding(
 				key.WithKeys("enter"),
 				key.WithHelp("enter", "press button"),
 			),
+			Retry: key.NewBinding(
+				key.WithKeys("y"),
+				key.WithHelp("y", "retry incorrect"),
+			),
+			DeclineRetry: key.NewBinding(
+				key.WithKeys("n"),
+				key.WithHelp("n", "don't retry"),
+			),
 			PreviousFocus: key.NewBinding(
 				key.WithKeys("["),
 				key.WithHelp("[", "focus previous"),
@@ -140,8 +319,14 @@ func (m *Model) KeyMap() help.KeyMap {
 				key.WithHelp("ctrl+q", "quit"),
 			),
 		}
+		m.options.KeyOverrides.Remap("Retry", &keyMap.Retry)
+		m.options.KeyOverrides.Remap("DeclineRetry", &keyMap.DeclineRetry)
+		keyMap.Retry.SetEnabled(m.offerRetry())
+		keyMap.DeclineRetry.SetEnabled(m.offerRetry())
+
+		return keyMap
 
 	default:
-		panic("unreachable")
+		panic("unknown session status: " + m.appStatus.String())
 	}
 }
@@ -0,0 +1,93 @@
+package session
+
+import (
+	"testing"
+
+	"charm.land/bubbles/v2/key"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questioncomponents"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+)
+
+func TestKeyMapOverrides(t *testing.T) {
+	overrides := questioncomponents.KeyOverrides{
+		"Up":               {"w"},
+		"Down":             {"s"},
+		"CloseReview":      {"q"},
+		"Mark":             {"ctrl+b"},
+		"PreviousQuestion": {"ctrl+o"},
+		"Resume":           {"r"},
+		"StartOver":        {"x"},
+		"Retry":            {"ctrl+y"},
+		"DeclineRetry":     {"ctrl+n"},
+		"ConfirmQuit":      {"ctrl+y"},
+	}
+
+	tests := map[string]struct {
+		setup func(m *Model)
+		keys  func(m *Model) map[*key.Binding][]string
+	}{
+		"Question": {
+			setup: func(*Model) {},
+			keys: func(m *Model) map[*key.Binding][]string {
+				keyMap := m.KeyMap().(questionKeyMap)
+				return map[*key.Binding][]string{&keyMap.Mark: {"ctrl+b"}, &keyMap.Previous: {"ctrl+o"}}
+			},
+		},
+		"Review": {
+			setup: func(m *Model) { m.viewingHistory = true },
+			keys: func(m *Model) map[*key.Binding][]string {
+				keyMap := m.KeyMap().(reviewKeyMap)
+				return map[*key.Binding][]string{&keyMap.Previous: {"w"}, &keyMap.Next: {"s"}, &keyMap.Close: {"q"}}
+			},
+		},
+		"ConfirmQuit": {
+			setup: func(m *Model) { m.confirmingQuit = true },
+			keys: func(m *Model) map[*key.Binding][]string {
+				keyMap := m.KeyMap().(confirmQuitKeyMap)
+				return map[*key.Binding][]string{&keyMap.Confirm: {"ctrl+y"}}
+			},
+		},
+		"Resume": {
+			setup: func(m *Model) {
+				m.appStatus = Uninitialised
+				m.pendingCheckpoint = &checkpoint{Total: 2}
+			},
+			keys: func(m *Model) map[*key.Binding][]string {
+				keyMap := m.KeyMap().(resumeKeyMap)
+				return map[*key.Binding][]string{&keyMap.Resume: {"r"}, &keyMap.StartOver: {"x"}}
+			},
+		},
+		"Completed": {
+			setup: func(m *Model) { m.appStatus = Completed },
+			keys: func(m *Model) map[*key.Binding][]string {
+				keyMap := m.KeyMap().(completedKeyMap)
+				return map[*key.Binding][]string{&keyMap.Retry: {"ctrl+y"}, &keyMap.DeclineRetry: {"ctrl+n"}}
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newTestSession(t, Options{KeyOverrides: overrides}, questions.Questions{typeIn("puer", "boy")})
+			tt.setup(m)
+
+			for binding, want := range tt.keys(m) {
+				assert.Equal(t, want, binding.Keys(), binding.Help().Desc)
+			}
+		})
+	}
+}
+
+func TestKeyMapMarkedHelp(t *testing.T) {
+	overrides := questioncomponents.KeyOverrides{"Mark": {"ctrl+b"}}
+
+	m := newTestSession(t, Options{KeyOverrides: overrides}, questions.Questions{typeIn("puer", "boy")})
+	m.toggleMarked()
+
+	// the help for the remapped key is kept when it changes to unmarking
+	help := m.KeyMap().(questionKeyMap).Mark.Help()
+	assert.Equal(t, "ctrl+b", help.Key)
+	assert.Equal(t, "unmark", help.Desc)
+}
@@ -0,0 +1,45 @@
+package session
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+)
+
+// toggleMarked marks the current question for later study, or unmarks it if it is already marked.
+func (m *Model) toggleMarked() {
+	prompt := m.currentQuestion.GetPrompt()
+
+	if i := slices.Index(m.marked, prompt); i >= 0 {
+		m.marked = slices.Delete(m.marked, i, i+1)
+		return
+	}
+
+	m.marked = append(m.marked, prompt)
+}
+
+// currentMarked reports whether the current question has been marked for later study.
+func (m *Model) currentMarked() bool {
+	return slices.Contains(m.marked, m.currentQuestion.GetPrompt())
+}
+
+// saveMarked writes the prompts of the questions marked for later study to a file, one per line.
+func saveMarked(filePath string, marked []string) tea.Cmd {
+	return func() tea.Msg {
+		var sb strings.Builder
+		for _, prompt := range marked {
+			sb.WriteString(prompt + "\n")
+		}
+
+		if err := os.WriteFile(filePath, []byte(sb.String()), 0o644); err != nil {
+			return app.ErrMsg(fmt.Errorf("failed to save marked questions to %s: %w", filePath, err))
+		}
+
+		return nil
+	}
+}
@@ -1,8 +1,16 @@
 package session
 
 import (
+	"math/rand/v2"
+	"time"
+
+	"charm.land/bubbles/v2/progress"
+	"charm.land/bubbles/v2/spinner"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questioncomponents"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
 	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
 )
@@ -10,6 +18,7 @@ import (
 type (
 	returnButton  struct{ focused bool }
 	restartButton struct{ focused bool }
+	reviewButton  struct{ focused bool }
 )
 
 func (rtb *returnButton) Focus() {
@@ -36,8 +45,36 @@ func (rsb *restartButton) Focused() bool {
 	return rsb.focused
 }
 
+func (rvb *reviewButton) Focus() {
+	rvb.focused = true
+}
+
+func (rvb *reviewButton) Blur() {
+	rvb.focused = false
+}
+
+func (rvb *reviewButton) Focused() bool {
+	return rvb.focused
+}
+
+// answeredQuestion is a question that has been answered, kept so that it can be reviewed later in the session.
+type answeredQuestion struct {
+	prompt, response, answer string
+	correct                  bool
+	hinted                   bool // whether a hint was used to answer the question
+	gaveUp                   bool // whether the user gave up on the question instead of answering it
+	mode                     questions.QuestionMode
+	question                 questions.Question
+}
+
+// modeScore is the score for questions of a single mode.
+type modeScore struct {
+	correct, total int
+}
+
 type testingSessionStatus int
 
+//go:generate go tool stringer -type=testingSessionStatus -output=testing_session_status_gen.go
 const (
 	Unavailable testingSessionStatus = iota
 	Uninitialised
@@ -45,6 +82,41 @@ const (
 	Completed
 )
 
+// Options configures how a testing session is run.
+type Options struct {
+	ResultsPath   string // file to save the results to once completed, if not empty
+	Attempts      int    // number of attempts allowed at type-in and principal parts questions
+	QuestionsPath string // questions file to use instead of requesting questions from the server, if not empty
+	HistoryPath   string // results file of a previous session, whose missed words are asked more, if not empty
+	MarkedPath    string // file to save the prompts of questions marked for later study to once completed, if not empty
+
+	KeyOverrides questioncomponents.KeyOverrides // keys to use in the session instead of the defaults
+
+	Bell bool // whether to ring the terminal bell and flash the border when a question is answered incorrectly
+
+	// RevealAfter is how long a question can go unanswered before the user is made to give up on it, or 0 if there is
+	// no limit.
+	RevealAfter time.Duration
+
+	Order questions.Order // order to ask the questions in
+
+	// AllowFewer is whether to go ahead with the session if the vocab list cannot provide as many questions as were
+	// requested. Otherwise, the session stops with an error once the questions run out, or before it starts if the
+	// questions are all received up front to reorder them.
+	AllowFewer bool
+
+	ShuffleChoices bool   // whether to shuffle the options of multiple choice questions
+	Seed           uint64 // seed to shuffle the options and questions with, or 0 to seed from the current time
+
+	// StaticSpinner is whether to keep the loading spinner still, so that the output doesn't depend on how long the
+	// questions take to load.
+	StaticSpinner bool
+
+	// HideTimers is whether to hide the question and session timers while answering questions, so that the output
+	// doesn't depend on how long the session takes.
+	HideTimers bool
+}
+
 type Model struct {
 	// Layout state
 
@@ -53,9 +125,13 @@ type Model struct {
 	// Components
 
 	questionProvider     QuestionProvider
+	currentQuestion      questions.Question
 	currentQuestionModel questioncomponents.QuestionModel
 	returnButton         *returnButton
 	restartButton        *restartButton
+	reviewButton         *reviewButton
+	progressBar          progress.Model
+	loadingSpinner       spinner.Model // shown while the questions are being loaded
 
 	// Application state
 
@@ -63,35 +139,98 @@ type Model struct {
 	listVerified   *create.VerifyStatus
 	configVerified *create.VerifyStatus
 
-	answeredCount       int // number of questions that have been answered
-	correctCount        int // number of questions that were answered correctly
+	answeredCount       int     // number of questions that have been answered
+	correctCount        int     // number of questions that were answered correctly
+	score               float64 // credit awarded for the answered questions, including partially correct ones
+	skippedCount        int     // number of questions that were skipped without being answered
+	hintsUsed           int     // number of questions answered after using a hint
+	gaveUpCount         int     // number of questions given up on, which count as incorrect
+	previousSkipped     bool
+	streak              int // number of consecutive questions answered correctly
+	bestStreak          int // longest streak reached
+	scoreByMode         map[questions.QuestionMode]modeScore
+	history             []answeredQuestion // questions answered so far, in order
+	marked              []string           // prompts of the questions marked for later study, in the order marked
+	historyIndex        int                // index into history of the question being looked back at
+	viewingHistory      bool               // whether a previous question is being looked back at
+	reviewing           bool               // whether the missed questions are being reviewed
+	reviewIndex         int                // index of the missed question currently being reviewed
+	sessionStart        time.Time          // when the first question was shown
+	sessionDuration     time.Duration      // time taken to complete the session, once completed
+	questionStart       time.Time          // when the current question was shown
+	questionElapsed     time.Duration      // time taken to answer the current question, once answered
+	timerTickID         int
+	flashing            bool // whether the border is being flashed for an incorrect answer
+	flashID             int
+	confirmingQuit      bool          // whether the user is being asked to confirm quitting
+	quitPromptStart     time.Time     // when the user was asked to confirm quitting
+	retrying            bool          // whether the incorrectly answered questions are being retried
+	checkpointPath      string        // where the session is checkpointed, empty if it isn't being checkpointed
+	pendingCheckpoint   *checkpoint   // checkpoint the user is being offered to resume from
+	questionOffset      int           // number of questions done before the session was resumed
+	resumedElapsed      time.Duration // time spent on the session before it was resumed
+	questionsRequested  int           // number of questions requested, if fewer were available, 0 otherwise
+	retryDeclined       bool          // whether the user has chosen not to retry the incorrectly answered questions
+	firstRoundCorrect   int           // number of questions answered correctly before retrying
+	firstRoundAnswered  int           // number of questions answered before retrying
+	showHelpHint        bool          // whether to point out the help key, until the first key is pressed in a session
 	dropdownActive      bool
 	activeDropdownIndex int
-	serverPort          int
+	server              app.ServerOptions
+	options             Options
+	rng                 *rand.Rand // source used to shuffle multiple choice options, nil if not shuffling
+	orderRNG            *rand.Rand // source used to shuffle the questions, nil if not in a random order
 	vocabList           *string
 	sessionConfig       **pb.SessionConfig
 	numberOfQuestions   *int
+	marking             *questions.MarkingOptions // how answers are marked
 	appStatus           testingSessionStatus
 }
 
 func New(
 	listVerified, configVerified *create.VerifyStatus,
-	serverPort int,
+	server app.ServerOptions,
+	options Options,
 	vocabList *string,
 	sessionConfig **pb.SessionConfig,
 	numberOfQuestions *int,
+	marking *questions.MarkingOptions,
 	styles *styles.StylesWrapper,
 ) *Model {
+	seed := options.Seed
+	if seed == 0 {
+		seed = uint64(time.Now().UnixNano())
+	}
+
+	var rng, orderRNG *rand.Rand
+	if options.ShuffleChoices {
+		rng = rand.New(rand.NewPCG(seed, seed))
+	}
+
+	if options.Order == questions.OrderRandom {
+		// separate from rng, as the questions are shuffled by a command rather than in Update
+		orderRNG = rand.New(rand.NewPCG(seed, seed))
+	}
+
 	return &Model{
 		returnButton:      &returnButton{},
 		restartButton:     &restartButton{},
+		reviewButton:      &reviewButton{},
+		progressBar:       progress.New(progress.WithoutPercentage()),
+		loadingSpinner:    spinner.New(spinner.WithSpinner(spinner.Dot)),
 		styles:            styles,
 		listVerified:      listVerified,
 		configVerified:    configVerified,
-		serverPort:        serverPort,
+		server:            server,
+		options:           options,
+		rng:               rng,
+		orderRNG:          orderRNG,
 		vocabList:         vocabList,
 		sessionConfig:     sessionConfig,
 		numberOfQuestions: numberOfQuestions,
+		marking:           marking,
+		scoreByMode:       make(map[questions.QuestionMode]modeScore),
 		appStatus:         Unavailable,
+		showHelpHint:      true,
 	}
 }
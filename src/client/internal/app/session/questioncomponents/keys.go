@@ -0,0 +1,52 @@
+package questioncomponents
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+)
+
+// KeyOverrides maps the names of the actions used in a session to the keys that trigger them, replacing their default
+// keys, e.g. {"Submit": ["ctrl+j"]}. Actions that are not given keep their default keys.
+type KeyOverrides map[string][]string
+
+// keyActions are the actions that can be given keys in [KeyOverrides]: first those used to answer questions, including
+// moving up and down the options of a dropdown, then those handled by the session itself. Up and Down also move
+// through the questions when reviewing them. The focus, help and quit keys are handled by the root page, so they can't
+// be remapped here.
+var keyActions = []string{
+	"Submit", "NextQuestion", "Skip", "GiveUp", "Hint", "OpenDropdown", "Up", "Down",
+	"Mark", "PreviousQuestion", "CloseReview", "Resume", "StartOver", "Retry", "DeclineRetry", "ConfirmQuit",
+}
+
+// Validate checks that every action in the overrides exists and is given at least one key.
+func (k KeyOverrides) Validate() error {
+	for _, action := range slices.Sorted(maps.Keys(k)) {
+		if !slices.Contains(keyActions, action) {
+			return fmt.Errorf("unknown action %q (expected one of %s)", action, strings.Join(keyActions, ", "))
+		}
+
+		if len(k[action]) == 0 {
+			return fmt.Errorf("no keys given for action %q", action)
+		}
+	}
+
+	return nil
+}
+
+// Remap replaces the keys of the bindings with the keys given for action, if there are any, keeping the help
+// descriptions of the bindings.
+func (k KeyOverrides) Remap(action string, bindings ...*key.Binding) {
+	keys, ok := k[action]
+	if !ok {
+		return
+	}
+
+	for _, b := range bindings {
+		b.SetKeys(keys...)
+		b.SetHelp(strings.Join(keys, "/"), b.Help().Desc)
+	}
+}
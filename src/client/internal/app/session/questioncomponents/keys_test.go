@@ -0,0 +1,102 @@
+package questioncomponents
+
+import (
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/exp/teatest/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
+)
+
+func TestKeyOverridesValidate(t *testing.T) {
+	tests := map[string]struct {
+		overrides KeyOverrides
+		wantErr   string
+	}{
+		"Empty":         {overrides: KeyOverrides{}},
+		"Valid":         {overrides: KeyOverrides{"Submit": {"ctrl+j"}, "Skip": {"ctrl+x", "ctrl+n"}}},
+		"SessionKeys":   {overrides: KeyOverrides{"Up": {"k"}, "Down": {"j"}, "Mark": {"m"}, "Retry": {"r"}}},
+		"UnknownAction": {overrides: KeyOverrides{"Jump": {"g"}}, wantErr: `unknown action "Jump"`},
+		"NoKeys":        {overrides: KeyOverrides{"Submit": {}}, wantErr: `no keys given for action "Submit"`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.overrides.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRemapKeys(t *testing.T) {
+	q := questions.TypeInLatToEngQuestion{TypeInLatToEngQuestion: &pb.TypeInLatToEngQuestion{
+		Prompt:     "prompt",
+		MainAnswer: "foo",
+		Answers:    []string{"foo"},
+	}}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
+	qc.RemapKeys(KeyOverrides{"Submit": {"ctrl+j"}})
+
+	assert.Equal(t, "ctrl+j", qc.unansweredKeyMap.Submit.Help().Key)
+	assert.Equal(t, "submit", qc.unansweredKeyMap.Submit.Help().Desc)
+
+	m := modelTI{QuestionComponent: qc}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
+	t.Cleanup(func() {
+		if err := tm.Quit(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// enter no longer submits, but the new key does
+	m.QuestionComponent.textinput.Focus()
+	tm.Type("foo")
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, Unanswered, m.QuestionComponent.QuestionStatus())
+
+	tm.Send(tea.KeyPressMsg{Code: 'j', Mod: tea.ModCtrl})
+	time.Sleep(10 * time.Millisecond)
+	tm.Quit()
+
+	fm := tm.FinalModel(t)
+
+	m, ok := fm.(modelTI)
+	if !ok {
+		t.Fatalf("final model have the wrong type: %T", fm)
+	}
+
+	assert.Equal(t, Correct, m.QuestionComponent.QuestionStatus())
+}
+
+func TestRemapDropdownKeys(t *testing.T) {
+	q := questions.ParseWordLatToCompQuestion{ParseWordLatToCompQuestion: &pb.ParseWordLatToCompQuestion{
+		Prompt:          "prompt",
+		DictionaryEntry: "dictionary entry",
+		MainAnswer:      &pb.EndingComponents{Case: pb.Case_CASE_GENITIVE, Number: pb.Number_NUMBER_PLURAL},
+		Answers:         []*pb.EndingComponents{{Case: pb.Case_CASE_GENITIVE, Number: pb.Number_NUMBER_PLURAL}},
+	}}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewParseQuestionModel(&q, &s)
+	qc.RemapKeys(KeyOverrides{"Up": {"w"}, "Down": {"s", "ctrl+n"}})
+
+	require.NotEmpty(t, qc.Dropdowns)
+
+	for _, d := range qc.Dropdowns {
+		up, down := d.CursorKeys()
+		assert.Equal(t, []string{"w"}, up.Keys())
+		assert.Equal(t, []string{"s", "ctrl+n"}, down.Keys())
+		assert.Equal(t, "s/ctrl+n", down.Help().Key)
+	}
+}
@@ -3,6 +3,8 @@ package questioncomponents
 import (
 	"fmt"
 	"image/color"
+	"math/rand/v2"
+	"slices"
 
 	"charm.land/bubbles/v2/help"
 	"charm.land/bubbles/v2/key"
@@ -50,11 +52,20 @@ type MultipleChoiceQuestionModel struct {
 	status           QuestionStatus
 }
 
+// NewMultipleChoiceQuestionModel creates a multiple choice question. If rng is not nil, it is used to shuffle the
+// order that the choices are shown in.
 func NewMultipleChoiceQuestionModel(
 	question questions.Question,
+	rng *rand.Rand,
 	styles *styles.StylesWrapper,
 ) *MultipleChoiceQuestionModel {
 	choices := question.(questions.MultipleChoiceQuestion).GetChoices()
+	if rng != nil {
+		choices = slices.Clone(choices)
+		rng.Shuffle(len(choices), func(i, j int) {
+			choices[i], choices[j] = choices[j], choices[i]
+		})
+	}
 
 	options := make([]*optionWrapper, len(choices))
 	for i, option := range choices {
@@ -73,6 +84,14 @@ func NewMultipleChoiceQuestionModel(
 			key.WithKeys("enter", "ctrl+enter"),
 			key.WithHelp("enter", "submit"),
 		),
+		Skip: key.NewBinding(
+			key.WithKeys("ctrl+s"),
+			key.WithHelp("ctrl+s", "skip question"),
+		),
+		GiveUp: key.NewBinding(
+			key.WithKeys("ctrl+g"),
+			key.WithHelp("ctrl+g", "give up and show answer"),
+		),
 		PreviousFocus: key.NewBinding(
 			key.WithKeys("["),
 			key.WithHelp("[", "focus previous"),
@@ -138,6 +157,8 @@ func (m *MultipleChoiceQuestionModel) Focused() bool {
 type unansweredMultipleChoiceKeyMap struct {
 	ChooseOption  key.Binding
 	Submit        key.Binding
+	Skip          key.Binding
+	GiveUp        key.Binding
 	PreviousFocus key.Binding
 	NextFocus     key.Binding
 	Help          key.Binding
@@ -150,7 +171,7 @@ func (k unansweredMultipleChoiceKeyMap) ShortHelp() []key.Binding {
 
 func (k unansweredMultipleChoiceKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.ChooseOption, k.Submit, k.PreviousFocus, k.NextFocus},
+		{k.ChooseOption, k.Submit, k.Skip, k.GiveUp, k.PreviousFocus, k.NextFocus},
 		{k.Help, k.Quit},
 	}
 }
@@ -174,6 +195,13 @@ func (k answeredMultipleChoiceKeyMap) FullHelp() [][]key.Binding {
 	}
 }
 
+func (m *MultipleChoiceQuestionModel) RemapKeys(overrides KeyOverrides) {
+	overrides.Remap("Submit", &m.unansweredKeyMap.Submit)
+	overrides.Remap("NextQuestion", &m.answeredKeyMap.NextQuestion)
+	overrides.Remap("Skip", &m.unansweredKeyMap.Skip)
+	overrides.Remap("GiveUp", &m.unansweredKeyMap.GiveUp)
+}
+
 func (m *MultipleChoiceQuestionModel) KeyMap() help.KeyMap {
 	if m.status == Unanswered {
 		return m.unansweredKeyMap
@@ -198,6 +226,20 @@ func (m *MultipleChoiceQuestionModel) QuestionStatus() QuestionStatus {
 	return m.status
 }
 
+func (m *MultipleChoiceQuestionModel) Response() string {
+	switch m.status {
+	case Correct:
+		return m.options[m.correctSelectedOptionIndex].Value
+
+	case Incorrect:
+		if m.incorrectSelectedOptionIndex >= 0 {
+			return m.options[m.incorrectSelectedOptionIndex].Value
+		}
+	}
+
+	return ""
+}
+
 func (m *MultipleChoiceQuestionModel) checkResponse() {
 	response := m.options[m.currentOptionIndex].Value
 
@@ -209,13 +251,32 @@ func (m *MultipleChoiceQuestionModel) checkResponse() {
 		m.status = Incorrect
 
 		m.incorrectSelectedOptionIndex = m.currentOptionIndex
-		for i := range m.options { // look for the actual correct option
-			if m.question.Check(m.options[i].Value) {
-				m.correctSelectedOptionIndex = i
-				break
-			}
+		m.correctSelectedOptionIndex = m.correctOptionIndex()
+	}
+}
+
+// GiveUp marks the question incorrect without selecting an option, so that only the correct option is highlighted.
+func (m *MultipleChoiceQuestionModel) GiveUp() tea.Cmd {
+	if m.status != Unanswered {
+		return nil
+	}
+
+	m.status = Incorrect
+	m.incorrectSelectedOptionIndex = -1
+	m.correctSelectedOptionIndex = m.correctOptionIndex()
+
+	return util.MsgCmd(QuestionAnsweredMsg{GaveUp: true})
+}
+
+// correctOptionIndex returns the index of the correct option.
+func (m *MultipleChoiceQuestionModel) correctOptionIndex() int {
+	for i := range m.options {
+		if m.question.Check(m.options[i].Value) {
+			return i
 		}
 	}
+
+	return -1
 }
 
 func (m *MultipleChoiceQuestionModel) Update(msg tea.Msg) (QuestionModel, tea.Cmd) {
@@ -238,10 +299,12 @@ func (m *MultipleChoiceQuestionModel) Update(msg tea.Msg) (QuestionModel, tea.Cm
 				// The outer int() converts from rune (int32) to int, since we want
 				// to use this as an index.
 				digit := int(msg.Code - '0')
-				if digit > 0 && digit <= m.numberOptions {
-					m.currentOptionIndex = digit - 1 // e.g. "1" selects option 0
+				if digit == 0 || digit > m.numberOptions {
+					// ignore digits that don't correspond to an option, rather than submitting the current one
+					return m, nil
 				}
 
+				m.currentOptionIndex = digit - 1 // e.g. "1" selects option 0
 				m.checkResponse()
 
 				return m, tea.Batch(
@@ -250,6 +313,18 @@ func (m *MultipleChoiceQuestionModel) Update(msg tea.Msg) (QuestionModel, tea.Cm
 					}),
 					util.MsgCmd(QuestionAnsweredMsg{}),
 				)
+			} else if key.Matches(msg, m.unansweredKeyMap.Skip) {
+				navigables := make([]navigator.Navigable, m.numberOptions)
+				for i := range m.options {
+					navigables[i] = m.options[i]
+				}
+
+				return m, tea.Batch(
+					util.MsgCmd(QuestionSkippedMsg{}),
+					util.MsgCmd(navigator.RemoveNavigableMsg{Components: navigables}),
+				)
+			} else if key.Matches(msg, m.unansweredKeyMap.GiveUp) {
+				return m, m.GiveUp()
 			} else if key.Matches(msg, m.unansweredKeyMap.Submit) {
 				for i := range m.numberOptions {
 					if m.options[i].Focused() {
@@ -313,6 +388,8 @@ func (m *MultipleChoiceQuestionModel) View() string {
 		panic("unreachable")
 	}
 
+	promptView = wrapPrompt(promptView, m.width)
+
 	// TODO: refactor def poss here
 	var optionColor color.Color
 
@@ -357,7 +434,7 @@ func (m *MultipleChoiceQuestionModel) View() string {
 		}
 
 	default:
-		panic("unreachable")
+		panic("unknown question status: " + m.status.String())
 	}
 
 	inputView := lipgloss.JoinVertical(lipgloss.Left, optionViews...)
@@ -1,6 +1,7 @@
 package questioncomponents
 
 import (
+	"math/rand/v2"
 	"testing"
 	"time"
 
@@ -57,7 +58,7 @@ func TestMultipleChoiceLatToEng(t *testing.T) {
 		},
 	}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewMultipleChoiceQuestionModel(&q, &s)
+	qc := NewMultipleChoiceQuestionModel(&q, nil, &s)
 
 	view := qc.View()
 	assert.Contains(t, view, "Translate")
@@ -79,7 +80,7 @@ func TestMultipleChoiceEngToLat(t *testing.T) {
 		},
 	}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewMultipleChoiceQuestionModel(&q, &s)
+	qc := NewMultipleChoiceQuestionModel(&q, nil, &s)
 
 	view := qc.View()
 	assert.Contains(t, view, "Translate")
@@ -92,6 +93,33 @@ func TestMultipleChoiceEngToLat(t *testing.T) {
 	golden.RequireEqual(t, []byte(view))
 }
 
+func TestMultipleChoiceShuffle(t *testing.T) {
+	q := questions.MultipleChoiceLatToEngQuestion{
+		MultipleChoiceLatToEngQuestion: &pb.MultipleChoiceLatToEngQuestion{
+			Prompt:  "prompt",
+			Choices: []string{"foo", "bar", "baz", "qux", "quux"},
+			Answer:  "baz",
+		},
+	}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+
+	optionValues := func(m *MultipleChoiceQuestionModel) []string {
+		values := make([]string, len(m.options))
+		for i, o := range m.options {
+			values[i] = o.Value
+		}
+
+		return values
+	}
+
+	qc1 := NewMultipleChoiceQuestionModel(&q, rand.New(rand.NewPCG(1, 1)), &s)
+	qc2 := NewMultipleChoiceQuestionModel(&q, rand.New(rand.NewPCG(1, 1)), &s)
+
+	assert.Equal(t, optionValues(qc1), optionValues(qc2), "same seed should give the same order")
+	assert.ElementsMatch(t, q.Choices, optionValues(qc1))
+	assert.Equal(t, []string{"foo", "bar", "baz", "qux", "quux"}, q.Choices, "choices should not be modified")
+}
+
 func TestMultipleChoiceCorrect(t *testing.T) { //nolint:dupl
 	q := questions.MultipleChoiceLatToEngQuestion{
 		MultipleChoiceLatToEngQuestion: &pb.MultipleChoiceLatToEngQuestion{
@@ -101,7 +129,7 @@ func TestMultipleChoiceCorrect(t *testing.T) { //nolint:dupl
 		},
 	}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewMultipleChoiceQuestionModel(&q, &s)
+	qc := NewMultipleChoiceQuestionModel(&q, nil, &s)
 
 	m := modelMC{QuestionComponent: qc}
 	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
@@ -153,7 +181,7 @@ func TestMultipleChoiceIncorrect(t *testing.T) { //nolint:dupl
 		},
 	}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewMultipleChoiceQuestionModel(&q, &s)
+	qc := NewMultipleChoiceQuestionModel(&q, nil, &s)
 
 	m := modelMC{QuestionComponent: qc}
 	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
@@ -205,7 +233,7 @@ func TestMultipleChoiceNumberSelect(t *testing.T) {
 		},
 	}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewMultipleChoiceQuestionModel(&q, &s)
+	qc := NewMultipleChoiceQuestionModel(&q, nil, &s)
 
 	m := modelMC{QuestionComponent: qc}
 	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
@@ -242,6 +270,78 @@ func TestMultipleChoiceNumberSelect(t *testing.T) {
 	)
 }
 
+func TestMultipleChoiceNumberOutOfRange(t *testing.T) {
+	q := questions.MultipleChoiceLatToEngQuestion{
+		MultipleChoiceLatToEngQuestion: &pb.MultipleChoiceLatToEngQuestion{
+			Prompt:  "prompt",
+			Choices: []string{"foo", "bar", "baz"},
+			Answer:  "foo",
+		},
+	}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewMultipleChoiceQuestionModel(&q, nil, &s)
+
+	m := modelMC{QuestionComponent: qc}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
+	t.Cleanup(func() {
+		if err := tm.Quit(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// neither 0 nor a number greater than the number of options should submit the question
+	tm.Send(tea.KeyPressMsg{Code: '0', Text: "0"})
+	tm.Send(tea.KeyPressMsg{Code: '4', Text: "4"})
+	time.Sleep(10 * time.Millisecond)
+	tm.Quit()
+
+	fm := tm.FinalModel(t)
+
+	m, ok := fm.(modelMC)
+	if !ok {
+		t.Fatalf("final model have the wrong type: %T", fm)
+	}
+
+	assert.Nil(t, m.CurrentMsg)
+	assert.Equal(t, Unanswered, m.QuestionComponent.QuestionStatus())
+}
+
+func TestMultipleChoiceGiveUp(t *testing.T) {
+	q := questions.MultipleChoiceLatToEngQuestion{
+		MultipleChoiceLatToEngQuestion: &pb.MultipleChoiceLatToEngQuestion{
+			Prompt:  "prompt",
+			Choices: []string{"foo", "bar", "baz"},
+			Answer:  "baz",
+		},
+	}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewMultipleChoiceQuestionModel(&q, nil, &s)
+
+	m := modelMC{QuestionComponent: qc}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
+	t.Cleanup(func() {
+		if err := tm.Quit(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	tm.Send(tea.KeyPressMsg{Code: 'g', Mod: tea.ModCtrl})
+	time.Sleep(10 * time.Millisecond)
+	tm.Quit()
+
+	fm := tm.FinalModel(t)
+
+	m, ok := fm.(modelMC)
+	if !ok {
+		t.Fatalf("final model have the wrong type: %T", fm)
+	}
+
+	assert.Equal(t, QuestionAnsweredMsg{GaveUp: true}, m.CurrentMsg)
+	assert.Equal(t, Incorrect, m.QuestionComponent.QuestionStatus())
+	assert.Equal(t, 2, m.QuestionComponent.correctSelectedOptionIndex)
+	assert.Empty(t, m.QuestionComponent.Response())
+}
+
 func TestMultipleChoiceNextQuestion(t *testing.T) {
 	q := questions.MultipleChoiceLatToEngQuestion{
 		MultipleChoiceLatToEngQuestion: &pb.MultipleChoiceLatToEngQuestion{
@@ -251,7 +351,7 @@ func TestMultipleChoiceNextQuestion(t *testing.T) {
 		},
 	}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewMultipleChoiceQuestionModel(&q, &s)
+	qc := NewMultipleChoiceQuestionModel(&q, nil, &s)
 
 	m := modelMC{QuestionComponent: qc}
 	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
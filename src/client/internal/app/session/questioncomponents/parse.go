@@ -167,6 +167,14 @@ func NewParseQuestionModel(question questions.Question, styles *styles.StylesWra
 			key.WithKeys("ctrl+enter"),
 			key.WithHelp("ctrl+enter", "submit"),
 		),
+		Skip: key.NewBinding(
+			key.WithKeys("ctrl+s"),
+			key.WithHelp("ctrl+s", "skip question"),
+		),
+		GiveUp: key.NewBinding(
+			key.WithKeys("ctrl+g"),
+			key.WithHelp("ctrl+g", "give up and show answer"),
+		),
 		PreviousFocus: key.NewBinding(
 			key.WithKeys("["),
 			key.WithHelp("[", "focus previous"),
@@ -233,6 +241,8 @@ func (m *ParseQuestionModel) Focused() bool {
 type unansweredParseKeyMap struct {
 	OpenDropdown  key.Binding
 	Submit        key.Binding
+	Skip          key.Binding
+	GiveUp        key.Binding
 	PreviousFocus key.Binding
 	NextFocus     key.Binding
 	Help          key.Binding
@@ -245,7 +255,7 @@ func (k unansweredParseKeyMap) ShortHelp() []key.Binding {
 
 func (k unansweredParseKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.OpenDropdown, k.Submit, k.PreviousFocus, k.NextFocus},
+		{k.OpenDropdown, k.Submit, k.Skip, k.GiveUp, k.PreviousFocus, k.NextFocus},
 		{k.Help, k.Quit},
 	}
 }
@@ -269,6 +279,20 @@ func (k answeredParseKeyMap) FullHelp() [][]key.Binding {
 	}
 }
 
+func (m *ParseQuestionModel) RemapKeys(overrides KeyOverrides) {
+	overrides.Remap("OpenDropdown", &m.unansweredKeyMap.OpenDropdown)
+	overrides.Remap("Submit", &m.unansweredKeyMap.Submit)
+	overrides.Remap("NextQuestion", &m.answeredKeyMap.NextQuestion)
+	overrides.Remap("Skip", &m.unansweredKeyMap.Skip)
+	overrides.Remap("GiveUp", &m.unansweredKeyMap.GiveUp)
+
+	for _, d := range m.Dropdowns {
+		up, down := d.CursorKeys()
+		overrides.Remap("Up", up)
+		overrides.Remap("Down", down)
+	}
+}
+
 func (m *ParseQuestionModel) KeyMap() help.KeyMap {
 	if m.status == Unanswered {
 		return m.unansweredKeyMap
@@ -293,15 +317,52 @@ func (m *ParseQuestionModel) QuestionStatus() QuestionStatus {
 	return m.status
 }
 
+func (m *ParseQuestionModel) Response() string {
+	response := make([]string, m.numberDropdowns)
+	for i, d := range m.Dropdowns {
+		response[i] = d.LastSelected.String()
+	}
+
+	return strings.Join(response, " ")
+}
+
 // Update updates the parse question model.
 //
 // Note that this does not update the dropdowns themselves. This should be handled by the main page model instead.
+func (m *ParseQuestionModel) GiveUp() tea.Cmd {
+	if m.status != Unanswered {
+		return nil
+	}
+
+	m.status = Incorrect
+
+	return util.MsgCmd(QuestionAnsweredMsg{GaveUp: true})
+}
+
 func (m *ParseQuestionModel) Update(msg tea.Msg) (QuestionModel, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
 		switch {
+		case key.Matches(msg, m.unansweredKeyMap.Skip):
+			if m.status == Unanswered {
+				navigables := make([]navigator.Navigable, m.numberDropdowns)
+				for i := range m.Dropdowns {
+					navigables[i] = m.Dropdowns[i]
+				}
+
+				return m, tea.Batch(
+					util.MsgCmd(QuestionSkippedMsg{}),
+					util.MsgCmd(navigator.RemoveNavigableMsg{Components: navigables}),
+				)
+			}
+
+		case key.Matches(msg, m.unansweredKeyMap.GiveUp):
+			if m.status == Unanswered {
+				return m, m.GiveUp()
+			}
+
 		case key.Matches(msg, m.unansweredKeyMap.OpenDropdown):
 			if m.status == Unanswered {
 				for i, d := range m.Dropdowns {
@@ -380,6 +441,8 @@ func (m *ParseQuestionModel) View() string {
 		m.styles.Italic.Render(m.question.GetPrompt()),
 	)
 
+	promptView = wrapPrompt(promptView, m.width)
+
 	dropdownViews := make([]string, m.numberDropdowns)
 	for i, d := range m.Dropdowns {
 		dropdownViews[i] = m.styles.DropdownButton(
@@ -404,9 +467,12 @@ func (m *ParseQuestionModel) View() string {
 
 	inputView := lipgloss.JoinHorizontal(lipgloss.Top, dropdownViews...)
 
-	return lipgloss.JoinVertical(
-		lipgloss.Left,
-		promptView,
-		lipgloss.JoinHorizontal(lipgloss.Top, inputView, resultView),
-	)
+	views := []string{promptView, lipgloss.JoinHorizontal(lipgloss.Top, inputView, resultView)}
+
+	// show the word the prompt comes from once answered, for context
+	if entry, _ := questions.GetComponents(m.question); m.status != Unanswered && entry != "" {
+		views = append(views, m.styles.Faint.Render("From: "+entry))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, views...)
 }
@@ -211,7 +211,8 @@ func TestParseCorrect(t *testing.T) {
 
 func TestParseIncorrect(t *testing.T) {
 	q := questions.ParseWordLatToCompQuestion{ParseWordLatToCompQuestion: &pb.ParseWordLatToCompQuestion{
-		Prompt: "prompt",
+		Prompt:          "prompt",
+		DictionaryEntry: "dictionary entry",
 		MainAnswer: &pb.EndingComponents{
 			Case:          pb.Case_CASE_GENITIVE,
 			Number:        pb.Number_NUMBER_PLURAL,
@@ -298,6 +299,7 @@ func TestParseIncorrect(t *testing.T) {
 	assert.Contains(t, view, "masculine")
 	assert.Contains(t, view, "genitive plural neuter")
 	assert.NotContains(t, view, "feminine")
+	assert.Contains(t, view, "From: dictionary entry")
 
 	golden.RequireEqual(t, []byte(view))
 }
@@ -27,10 +27,17 @@ type PrincipalPartsQuestionModel struct {
 	unansweredKeyMap unansweredPrincipalPartsKeyMap
 	answeredKeyMap   answeredPrincipalPartsKeyMap
 	status           QuestionStatus
+	attemptsLeft     int // number of incorrect answers allowed before the question is marked incorrect
+	marking          questions.MarkingOptions
+	retryHint        bool
 }
 
+// NewPrincipalPartsQuestionModel creates a principal parts question, which allows the given number of attempts
+// before it is marked incorrect, and marks answers with the given options.
 func NewPrincipalPartsQuestionModel(
 	question questions.Question,
+	attempts int,
+	marking questions.MarkingOptions,
 	styles *styles.StylesWrapper,
 ) *PrincipalPartsQuestionModel {
 	pp := question.(*questions.PrincipalPartsQuestion).PrincipalParts
@@ -46,6 +53,14 @@ func NewPrincipalPartsQuestionModel(
 			key.WithKeys("enter", "ctrl+enter"),
 			key.WithHelp("enter", "submit"),
 		),
+		Skip: key.NewBinding(
+			key.WithKeys("ctrl+s"),
+			key.WithHelp("ctrl+s", "skip question"),
+		),
+		GiveUp: key.NewBinding(
+			key.WithKeys("ctrl+g"),
+			key.WithHelp("ctrl+g", "give up and show answer"),
+		),
 		PreviousFocus: key.NewBinding(
 			key.WithKeys("["),
 			key.WithHelp("[", "focus previous"),
@@ -94,6 +109,8 @@ func NewPrincipalPartsQuestionModel(
 		unansweredKeyMap: unansweredKeyMap,
 		answeredKeyMap:   answeredKeyMap,
 		status:           Unanswered,
+		attemptsLeft:     max(attempts, 1),
+		marking:          marking,
 	}
 }
 
@@ -109,6 +126,8 @@ func (m *PrincipalPartsQuestionModel) Focused() bool {
 
 type unansweredPrincipalPartsKeyMap struct {
 	Submit        key.Binding
+	Skip          key.Binding
+	GiveUp        key.Binding
 	PreviousFocus key.Binding
 	NextFocus     key.Binding
 	Help          key.Binding
@@ -121,7 +140,7 @@ func (k unansweredPrincipalPartsKeyMap) ShortHelp() []key.Binding {
 
 func (k unansweredPrincipalPartsKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Submit, k.PreviousFocus, k.NextFocus},
+		{k.Submit, k.Skip, k.GiveUp, k.PreviousFocus, k.NextFocus},
 		{k.Help, k.Quit},
 	}
 }
@@ -145,6 +164,13 @@ func (k answeredPrincipalPartsKeyMap) FullHelp() [][]key.Binding {
 	}
 }
 
+func (m *PrincipalPartsQuestionModel) RemapKeys(overrides KeyOverrides) {
+	overrides.Remap("Submit", &m.unansweredKeyMap.Submit)
+	overrides.Remap("NextQuestion", &m.answeredKeyMap.NextQuestion)
+	overrides.Remap("Skip", &m.unansweredKeyMap.Skip)
+	overrides.Remap("GiveUp", &m.unansweredKeyMap.GiveUp)
+}
+
 func (m *PrincipalPartsQuestionModel) KeyMap() help.KeyMap {
 	if m.status == Unanswered {
 		return m.unansweredKeyMap
@@ -170,19 +196,65 @@ func (m *PrincipalPartsQuestionModel) QuestionStatus() QuestionStatus {
 	return m.status
 }
 
+func (m *PrincipalPartsQuestionModel) Response() string {
+	return strings.Join(m.Responses(), ", ")
+}
+
+// Responses returns the response given for each part.
+func (m *PrincipalPartsQuestionModel) Responses() []string {
+	response := make([]string, m.numberTextinputs)
+	for i := range m.textinputs {
+		response[i] = m.textinputs[i].Value()
+	}
+
+	return response
+}
+
+func (m *PrincipalPartsQuestionModel) GiveUp() tea.Cmd {
+	if m.status != Unanswered {
+		return nil
+	}
+
+	m.retryHint = false
+	m.status = Incorrect
+
+	return util.MsgCmd(QuestionAnsweredMsg{GaveUp: true})
+}
+
 func (m *PrincipalPartsQuestionModel) Update(msg tea.Msg) (QuestionModel, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	if msg, ok := msg.(tea.KeyPressMsg); ok {
 		switch {
-		case key.Matches(msg, m.unansweredKeyMap.Submit):
+		case key.Matches(msg, m.unansweredKeyMap.Skip):
 			if m.status == Unanswered {
-				response := make([]string, m.numberTextinputs)
+				navigables := make([]navigator.Navigable, m.numberTextinputs)
 				for i := range m.textinputs {
-					response[i] = m.textinputs[i].Value()
+					navigables[i] = m.textinputs[i]
+				}
+
+				return m, tea.Batch(
+					util.MsgCmd(QuestionSkippedMsg{}),
+					util.MsgCmd(navigator.RemoveNavigableMsg{Components: navigables}),
+				)
+			}
+
+		case key.Matches(msg, m.unansweredKeyMap.GiveUp):
+			if m.status == Unanswered {
+				return m, m.GiveUp()
+			}
+
+		case key.Matches(msg, m.unansweredKeyMap.Submit):
+			if m.status == Unanswered {
+				m.attemptsLeft--
+
+				correct := questions.CheckResponse(m.question, m.Responses(), m.marking)
+				if !correct && m.attemptsLeft > 0 {
+					m.retryHint = true
+					return m, nil
 				}
 
-				correct := m.question.Check(response)
+				m.retryHint = false
 				if correct {
 					m.status = Correct
 				} else {
@@ -241,6 +313,8 @@ func (m *PrincipalPartsQuestionModel) View() string {
 		m.styles.Italic.Render(m.question.GetPrompt()),
 	)
 
+	promptView = wrapPrompt(promptView, m.width)
+
 	tiViews := make([]string, m.numberTextinputs)
 	for i, ti := range m.textinputs {
 		switch m.status {
@@ -269,6 +343,8 @@ func (m *PrincipalPartsQuestionModel) View() string {
 		footerView = m.styles.SessionPage.Incorrect.Render(
 			"✕ " + strings.Join(m.question.(*questions.PrincipalPartsQuestion).PrincipalParts, ", "),
 		)
+	} else if m.retryHint {
+		footerView = m.styles.Error.Render(fmt.Sprintf("Try again (%d left)", m.attemptsLeft))
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, promptView, inputView, footerView)
@@ -54,7 +54,7 @@ func TestPrincipalParts(t *testing.T) {
 		PrincipalParts: []string{"foo", "bar", "baz", "qux"},
 	}}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewPrincipalPartsQuestionModel(&q, &s)
+	qc := NewPrincipalPartsQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
 
 	view := qc.View()
 	assert.Contains(t, view, "Principal parts")
@@ -70,7 +70,7 @@ func TestPrincipalPartsCorrect(t *testing.T) {
 		PrincipalParts: []string{"foo", "bar", "baz", "qux"},
 	}}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewPrincipalPartsQuestionModel(&q, &s)
+	qc := NewPrincipalPartsQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
 
 	m := modelPP{QuestionComponent: qc}
 	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
@@ -132,7 +132,7 @@ func TestPrincipalPartsIncorrect(t *testing.T) {
 		PrincipalParts: []string{"foo", "bar", "baz", "qux"},
 	}}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewPrincipalPartsQuestionModel(&q, &s)
+	qc := NewPrincipalPartsQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
 
 	m := modelPP{QuestionComponent: qc}
 	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
@@ -197,7 +197,7 @@ func TestPrincipalPartsNextQuestion(t *testing.T) {
 		PrincipalParts: []string{"foo", "bar", "baz", "qux"},
 	}}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewPrincipalPartsQuestionModel(&q, &s)
+	qc := NewPrincipalPartsQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
 
 	m := modelPP{QuestionComponent: qc}
 	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
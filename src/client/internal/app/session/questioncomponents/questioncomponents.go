@@ -3,19 +3,28 @@ package questioncomponents
 import (
 	"charm.land/bubbles/v2/help"
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 )
 
 type (
-	NextQuestionMsg     struct{}
-	QuestionAnsweredMsg struct{}
+	NextQuestionMsg struct{}
+
+	// QuestionAnsweredMsg is sent when the question is answered, which includes giving up on it.
+	QuestionAnsweredMsg struct {
+		GaveUp bool // whether the user gave up, so the question was marked incorrect without a response
+	}
+
+	// QuestionSkippedMsg is sent instead of [NextQuestionMsg] when the question is skipped without being answered.
+	QuestionSkippedMsg struct{}
 )
 
 type QuestionStatus int
 
+//go:generate go tool stringer -type=QuestionStatus -linecomment -output=question_status_gen.go
 const (
-	Unanswered QuestionStatus = iota
-	Correct
-	Incorrect
+	Unanswered QuestionStatus = iota // unanswered
+	Correct                          // correct
+	Incorrect                        // incorrect
 )
 
 // XXX: Can the need for QuestionStatus be removed entirely eventually?
@@ -30,5 +39,26 @@ type QuestionModel interface {
 	KeyMap() help.KeyMap
 
 	QuestionStatus() QuestionStatus
+
+	// Response returns the response given by the user, formatted for display.
+	Response() string
+
+	// GiveUp marks an unanswered question as incorrect and shows the answer, as if the user had pressed the give up
+	// key. It does nothing if the question has already been answered.
+	GiveUp() tea.Cmd
+
+	// RemapKeys replaces the default keys of the question's actions with those given in the overrides.
+	RemapKeys(overrides KeyOverrides)
+
 	Focused() bool
 }
+
+// wrapPrompt wraps the rendered prompt of a question so that long prompts fit within width rather than overflowing it.
+// Prompts are left as they are if the width is not known yet.
+func wrapPrompt(promptView string, width int) string {
+	if width <= 0 {
+		return promptView
+	}
+
+	return lipgloss.Wrap(promptView, width, "")
+}
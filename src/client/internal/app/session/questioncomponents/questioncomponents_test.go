@@ -0,0 +1,20 @@
+package questioncomponents
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuestionStatusString(t *testing.T) {
+	tests := map[QuestionStatus]string{
+		Unanswered:         "unanswered",
+		Correct:            "correct",
+		Incorrect:          "incorrect",
+		QuestionStatus(99): "QuestionStatus(99)",
+	}
+
+	for status, want := range tests {
+		assert.Equal(t, want, status.String())
+	}
+}
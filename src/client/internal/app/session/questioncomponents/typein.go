@@ -2,7 +2,9 @@ package questioncomponents
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"charm.land/bubbles/v2/help"
 	"charm.land/bubbles/v2/key"
@@ -52,9 +54,22 @@ type TypeInQuestionModel struct {
 	unansweredKeyMap unansweredTypeInKeyMap
 	answeredKeyMap   answeredTypeInKeyMap
 	status           QuestionStatus
+	attemptsLeft     int // number of incorrect answers allowed before the question is marked incorrect
+	marking          questions.MarkingOptions
+	retryHint        bool
+	hinted           bool // whether the first letter of the answer has been revealed
+	revealed         int  // number of letters of the answer revealed by incorrect answers, if revealing progressively
 }
 
-func NewTypeInQuestionModel(question questions.Question, styles *styles.StylesWrapper) *TypeInQuestionModel {
+// NewTypeInQuestionModel creates a type-in question, which allows the given number of attempts before it is marked
+// incorrect, and marks answers with the given options. If the answer is revealed progressively, the question instead
+// allows another try for each letter of the answer.
+func NewTypeInQuestionModel(
+	question questions.Question,
+	attempts int,
+	marking questions.MarkingOptions,
+	styles *styles.StylesWrapper,
+) *TypeInQuestionModel {
 	ti := textinput.New()
 	ti.Blur()
 
@@ -63,6 +78,18 @@ func NewTypeInQuestionModel(question questions.Question, styles *styles.StylesWr
 			key.WithKeys("enter", "ctrl+enter"),
 			key.WithHelp("enter", "submit"),
 		),
+		Skip: key.NewBinding(
+			key.WithKeys("ctrl+s"),
+			key.WithHelp("ctrl+s", "skip question"),
+		),
+		GiveUp: key.NewBinding(
+			key.WithKeys("ctrl+g"),
+			key.WithHelp("ctrl+g", "give up and show answer"),
+		),
+		Hint: key.NewBinding(
+			key.WithKeys("ctrl+t"),
+			key.WithHelp("ctrl+t", "reveal first letter"),
+		),
 		PreviousFocus: key.NewBinding(
 			key.WithKeys("["),
 			key.WithHelp("[", "focus previous"),
@@ -110,6 +137,8 @@ func NewTypeInQuestionModel(question questions.Question, styles *styles.StylesWr
 		unansweredKeyMap: unansweredKeyMap,
 		answeredKeyMap:   answeredKeyMap,
 		status:           Unanswered,
+		attemptsLeft:     max(attempts, 1),
+		marking:          marking,
 	}
 }
 
@@ -119,6 +148,9 @@ func (m *TypeInQuestionModel) Focused() bool {
 
 type unansweredTypeInKeyMap struct {
 	Submit        key.Binding
+	Skip          key.Binding
+	GiveUp        key.Binding
+	Hint          key.Binding
 	PreviousFocus key.Binding
 	NextFocus     key.Binding
 	Help          key.Binding
@@ -131,7 +163,7 @@ func (k unansweredTypeInKeyMap) ShortHelp() []key.Binding {
 
 func (k unansweredTypeInKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Submit, k.PreviousFocus, k.NextFocus},
+		{k.Submit, k.Skip, k.GiveUp, k.Hint, k.PreviousFocus, k.NextFocus},
 		{k.Help, k.Quit},
 	}
 }
@@ -155,6 +187,14 @@ func (k answeredTypeInKeyMap) FullHelp() [][]key.Binding {
 	}
 }
 
+func (m *TypeInQuestionModel) RemapKeys(overrides KeyOverrides) {
+	overrides.Remap("Submit", &m.unansweredKeyMap.Submit)
+	overrides.Remap("NextQuestion", &m.answeredKeyMap.NextQuestion)
+	overrides.Remap("Skip", &m.unansweredKeyMap.Skip)
+	overrides.Remap("GiveUp", &m.unansweredKeyMap.GiveUp)
+	overrides.Remap("Hint", &m.unansweredKeyMap.Hint)
+}
+
 func (m *TypeInQuestionModel) KeyMap() help.KeyMap {
 	if m.status == Unanswered {
 		return m.unansweredKeyMap
@@ -175,14 +215,92 @@ func (m *TypeInQuestionModel) QuestionStatus() QuestionStatus {
 	return m.status
 }
 
+// Hinted reports whether the hint was used to reveal the first letter of the answer. Letters revealed by incorrect
+// answers are not counted, as they are instead taken off the credit (see [TypeInQuestionModel.RevealedFraction]).
+func (m *TypeInQuestionModel) Hinted() bool {
+	return m.hinted
+}
+
+// RevealedFraction returns the fraction of the letters of the answer that were revealed by incorrect answers, if the
+// answer is revealed progressively.
+func (m *TypeInQuestionModel) RevealedFraction() float64 {
+	if m.revealed == 0 {
+		return 0
+	}
+
+	return float64(m.revealed) / float64(utf8.RuneCountInString(m.question.GetMainAnswer().(string)))
+}
+
+// revealedLetters returns the number of letters of the answer to show as a hint.
+func (m *TypeInQuestionModel) revealedLetters() int {
+	if m.hinted {
+		return m.revealed + 1
+	}
+
+	return m.revealed
+}
+
+func (m *TypeInQuestionModel) Response() string {
+	return strings.TrimSpace(m.textinput.Value())
+}
+
+func (m *TypeInQuestionModel) GiveUp() tea.Cmd {
+	if m.status != Unanswered {
+		return nil
+	}
+
+	m.retryHint = false
+	m.status = Incorrect
+
+	return util.MsgCmd(QuestionAnsweredMsg{GaveUp: true})
+}
+
 func (m *TypeInQuestionModel) Update(msg tea.Msg) (QuestionModel, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	if msg, ok := msg.(tea.KeyPressMsg); ok {
 		switch {
+		case key.Matches(msg, m.unansweredKeyMap.Skip):
+			if m.status == Unanswered {
+				return m, tea.Batch(
+					util.MsgCmd(QuestionSkippedMsg{}),
+					util.MsgCmd(
+						navigator.RemoveNavigableMsg{
+							Components: []navigator.Navigable{m.textinput},
+						},
+					),
+				)
+			}
+
+		case key.Matches(msg, m.unansweredKeyMap.GiveUp):
+			if m.status == Unanswered {
+				return m, m.GiveUp()
+			}
+
+		case key.Matches(msg, m.unansweredKeyMap.Hint):
+			if m.status == Unanswered {
+				m.hinted = true
+				return m, nil
+			}
+
 		case key.Matches(msg, m.unansweredKeyMap.Submit):
 			if m.status == Unanswered {
-				correct := m.question.Check(strings.TrimSpace(m.textinput.Value()))
+				m.attemptsLeft--
+
+				correct := questions.CheckResponse(m.question, strings.TrimSpace(m.textinput.Value()), m.marking)
+				if !correct && m.marking.ProgressiveReveal {
+					// the question is only failed once every letter would have to be revealed
+					m.revealed++
+					if m.revealedLetters() < utf8.RuneCountInString(m.question.GetMainAnswer().(string)) {
+						m.retryHint = true
+						return m, nil
+					}
+				} else if !correct && m.attemptsLeft > 0 {
+					m.retryHint = true
+					return m, nil
+				}
+
+				m.retryHint = false
 				if correct {
 					m.status = Correct
 				} else {
@@ -216,6 +334,26 @@ func (m *TypeInQuestionModel) Update(msg tea.Msg) (QuestionModel, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// answerPrefix returns the first n letters of the main answer to the question.
+func answerPrefix(question questions.Question, n int) string {
+	answer := []rune(question.GetMainAnswer().(string))
+	return string(answer[:min(n, len(answer))])
+}
+
+// otherAnswers returns the accepted answers to the question apart from shown, which is the answer already shown to
+// the user. Macrons are ignored when comparing answers to shown, as they may not have been typed.
+func otherAnswers(question questions.Question, shown string) []string {
+	var others []string
+
+	for _, answer := range questions.GetAllAnswers(question) {
+		if !questions.EqualIgnoringMacrons(answer, shown) && !slices.Contains(others, answer) {
+			others = append(others, answer)
+		}
+	}
+
+	return others
+}
+
 func (m *TypeInQuestionModel) SetWidth(width int) {
 	m.width = width
 }
@@ -244,22 +382,42 @@ func (m *TypeInQuestionModel) View() string {
 		)
 
 	case *questions.ParseWordCompToLatQuestion:
+		components, _ := questions.GetComponents(q)
 		promptView = fmt.Sprintf(
 			"%s %s %s %s?",
 			m.styles.Text.Render("What is"),
 			m.styles.Italic.Render(q.Prompt),
 			m.styles.Text.Render("in the"),
-			q.Components.DisplayString,
+			components,
 		)
 
 	default:
 		panic("unreachable")
 	}
 
+	promptView = wrapPrompt(promptView, m.width)
+
 	var inputView string
 	switch m.status {
 	case Unanswered:
 		inputView = m.textinput.View()
+		if letters := m.revealedLetters(); letters > 0 {
+			inputView = lipgloss.JoinVertical(
+				lipgloss.Left,
+				inputView,
+				m.styles.Faint.Render(fmt.Sprintf("Hint: starts with %q", answerPrefix(m.question, letters))),
+			)
+		}
+
+		if m.retryHint && m.marking.ProgressiveReveal {
+			inputView = lipgloss.JoinVertical(lipgloss.Left, inputView, m.styles.Error.Render("Try again"))
+		} else if m.retryHint {
+			inputView = lipgloss.JoinVertical(
+				lipgloss.Left,
+				inputView,
+				m.styles.Error.Render(fmt.Sprintf("Try again (%d left)", m.attemptsLeft)),
+			)
+		}
 
 	case Correct:
 		m.textinput.Blur()
@@ -268,6 +426,14 @@ func (m *TypeInQuestionModel) View() string {
 		m.textinput.SetStyles(s)
 		inputView = m.textinput.View()
 
+		if others := otherAnswers(m.question, m.Response()); len(others) > 0 {
+			inputView = lipgloss.JoinVertical(
+				lipgloss.Left,
+				inputView,
+				m.styles.Faint.Render("Also accepted: "+strings.Join(others, ", ")),
+			)
+		}
+
 	case Incorrect:
 		m.textinput.Blur()
 		inputView = lipgloss.JoinHorizontal(
@@ -275,6 +441,14 @@ func (m *TypeInQuestionModel) View() string {
 			m.textinput.View(),
 			m.styles.SessionPage.Incorrect.Render(" ✕ "+m.question.GetMainAnswer().(string)),
 		)
+
+		if others := otherAnswers(m.question, m.question.GetMainAnswer().(string)); len(others) > 0 {
+			inputView = lipgloss.JoinVertical(
+				lipgloss.Left,
+				inputView,
+				m.styles.Faint.Render("Also accepted: "+strings.Join(others, ", ")),
+			)
+		}
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, promptView, inputView)
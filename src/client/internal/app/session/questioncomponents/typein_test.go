@@ -1,13 +1,17 @@
 package questioncomponents
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 	"github.com/charmbracelet/x/exp/golden"
 	"github.com/charmbracelet/x/exp/teatest/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
 	"github.com/rduo1009/vocab-tuister/src/client/internal/components/navigator"
@@ -55,7 +59,7 @@ func TestTypeInLatToEng(t *testing.T) {
 		Answers:    []string{"foo", "bar", "baz"},
 	}}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewTypeInQuestionModel(&q, &s)
+	qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
 
 	view := qc.View()
 	assert.Contains(t, view, "Translate")
@@ -72,7 +76,7 @@ func TestTypeInEngToLat(t *testing.T) {
 		Answers:    []string{"foo", "bar", "baz"},
 	}}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewTypeInQuestionModel(&q, &s)
+	qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
 
 	view := qc.View()
 	assert.Contains(t, view, "Translate")
@@ -82,13 +86,41 @@ func TestTypeInEngToLat(t *testing.T) {
 	golden.RequireEqual(t, []byte(view))
 }
 
+func TestTypeInWrapsLongPrompt(t *testing.T) {
+	const width = 30
+
+	q := questions.TypeInLatToEngQuestion{TypeInLatToEngQuestion: &pb.TypeInLatToEngQuestion{
+		Prompt:     "magister, qui in schola diu laborabat, discipulos tandem laudavit",
+		MainAnswer: "the teacher finally praised the pupils",
+		Answers:    []string{"the teacher finally praised the pupils"},
+	}}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
+	qc.SetWidth(width)
+
+	view := qc.View()
+	lines := strings.Split(view, "\n")
+
+	// the prompt is wrapped onto several lines rather than truncated
+	assert.Greater(t, len(lines), 2)
+
+	for _, line := range lines {
+		assert.LessOrEqual(t, lipgloss.Width(line), width, "line %q is too wide", line)
+	}
+
+	for word := range strings.FieldsSeq(q.Prompt) {
+		assert.Contains(t, view, word)
+	}
+}
+
 func TestTypeInCorrect(t *testing.T) {
 	tests := []struct {
 		name  string
 		input string
+		also  string
 	}{
-		{name: "TestTypeInCorrectMain", input: "foo"},
-		{name: "TestTypeInCorrectAlt", input: "bar"},
+		{name: "TestTypeInCorrectMain", input: "foo", also: "Also accepted: bar, baz"},
+		{name: "TestTypeInCorrectAlt", input: "bar", also: "Also accepted: foo, baz"},
 	}
 
 	for _, tt := range tests {
@@ -101,7 +133,7 @@ func TestTypeInCorrect(t *testing.T) {
 			s := styles.StylesWrapper{
 				Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false),
 			}
-			qc := NewTypeInQuestionModel(&q, &s)
+			qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
 
 			m := modelTI{QuestionComponent: qc}
 			tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
@@ -140,6 +172,7 @@ func TestTypeInCorrect(t *testing.T) {
 				"expected Correct, got %s",
 				m.QuestionComponent.QuestionStatus(),
 			)
+			assert.Contains(t, m.QuestionComponent.View(), tt.also)
 
 			golden.RequireEqual(t, []byte(m.QuestionComponent.View()))
 		})
@@ -153,7 +186,7 @@ func TestTypeInIncorrect(t *testing.T) {
 		Answers:    []string{"foo", "bar", "baz"},
 	}}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewTypeInQuestionModel(&q, &s)
+	qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
 
 	m := modelTI{QuestionComponent: qc}
 	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
@@ -194,6 +227,7 @@ func TestTypeInIncorrect(t *testing.T) {
 	)
 	assert.Contains(t, m.QuestionComponent.View(), "foo")
 	assert.Contains(t, m.QuestionComponent.View(), "qux")
+	assert.Contains(t, m.QuestionComponent.View(), "Also accepted: bar, baz")
 
 	golden.RequireEqual(t, []byte(m.QuestionComponent.View()))
 }
@@ -205,7 +239,7 @@ func TestTypeInNextQuestion(t *testing.T) {
 		Answers:    []string{"foo", "bar", "baz"},
 	}}
 	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
-	qc := NewTypeInQuestionModel(&q, &s)
+	qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
 
 	m := modelTI{QuestionComponent: qc}
 	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
@@ -242,3 +276,221 @@ func TestTypeInNextQuestion(t *testing.T) {
 	)
 	assert.Len(t, m.RemovedNavigables, 1)
 }
+
+func TestTypeInHint(t *testing.T) {
+	q := questions.TypeInLatToEngQuestion{TypeInLatToEngQuestion: &pb.TypeInLatToEngQuestion{
+		Prompt:     "prompt",
+		MainAnswer: "foo",
+		Answers:    []string{"foo", "bar", "baz"},
+	}}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
+
+	assert.NotContains(t, qc.View(), "Hint")
+
+	m := modelTI{QuestionComponent: qc}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
+	t.Cleanup(func() {
+		if err := tm.Quit(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	m.QuestionComponent.textinput.Focus()
+	tm.Send(tea.KeyPressMsg{Code: 't', Mod: tea.ModCtrl})
+	time.Sleep(10 * time.Millisecond)
+
+	teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+		return bytes.Contains(bts, []byte(`Hint: starts with "f"`))
+	}, teatest.WithDuration(time.Second))
+
+	tm.Type("foo")
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	time.Sleep(10 * time.Millisecond)
+	tm.Quit()
+
+	fm := tm.FinalModel(t)
+
+	m, ok := fm.(modelTI)
+	if !ok {
+		t.Fatalf("final model have the wrong type: %T", fm)
+	}
+
+	assert.Equal(t, Correct, m.QuestionComponent.QuestionStatus())
+	assert.True(t, m.QuestionComponent.Hinted())
+	assert.NotContains(t, m.QuestionComponent.View(), "Hint")
+}
+
+func TestTypeInGiveUp(t *testing.T) {
+	q := questions.TypeInLatToEngQuestion{TypeInLatToEngQuestion: &pb.TypeInLatToEngQuestion{
+		Prompt:     "prompt",
+		MainAnswer: "foo",
+		Answers:    []string{"foo"},
+	}}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewTypeInQuestionModel(&q, 3, questions.MarkingOptions{}, &s)
+
+	m := modelTI{QuestionComponent: qc}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
+	t.Cleanup(func() {
+		if err := tm.Quit(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// giving up should end the question even though attempts are left
+	m.QuestionComponent.textinput.Focus()
+	tm.Send(tea.KeyPressMsg{Code: 'g', Mod: tea.ModCtrl})
+	time.Sleep(10 * time.Millisecond)
+	tm.Quit()
+
+	fm := tm.FinalModel(t)
+
+	m, ok := fm.(modelTI)
+	if !ok {
+		t.Fatalf("final model have the wrong type: %T", fm)
+	}
+
+	assert.Equal(t, QuestionAnsweredMsg{GaveUp: true}, m.CurrentMsg)
+	assert.Equal(t, Incorrect, m.QuestionComponent.QuestionStatus())
+	assert.Contains(t, m.QuestionComponent.View(), "✕ foo")
+}
+
+func TestTypeInGiveUpOnce(t *testing.T) {
+	q := questions.TypeInLatToEngQuestion{TypeInLatToEngQuestion: &pb.TypeInLatToEngQuestion{
+		Prompt:     "prompt",
+		MainAnswer: "foo",
+		Answers:    []string{"foo"},
+	}}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{}, &s)
+
+	cmd := qc.GiveUp()
+	require.NotNil(t, cmd)
+	assert.Equal(t, QuestionAnsweredMsg{GaveUp: true}, cmd())
+	assert.Equal(t, Incorrect, qc.QuestionStatus())
+
+	// a question that has already been answered can't be given up on
+	assert.Nil(t, qc.GiveUp())
+}
+
+func TestTypeInAttempts(t *testing.T) {
+	q := questions.TypeInLatToEngQuestion{TypeInLatToEngQuestion: &pb.TypeInLatToEngQuestion{
+		Prompt:     "prompt",
+		MainAnswer: "foo",
+		Answers:    []string{"foo", "bar", "baz"},
+	}}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewTypeInQuestionModel(&q, 2, questions.MarkingOptions{}, &s)
+
+	m := modelTI{QuestionComponent: qc}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
+	t.Cleanup(func() {
+		if err := tm.Quit(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// simulate typing in "qux" (incorrect), which should be allowed a second attempt
+	m.QuestionComponent.textinput.Focus()
+	tm.Type("qux")
+
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	time.Sleep(10 * time.Millisecond)
+
+	teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+		return bytes.Contains(bts, []byte("Try again (1 left)"))
+	}, teatest.WithDuration(time.Second))
+
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	time.Sleep(10 * time.Millisecond)
+	tm.Quit()
+
+	fm := tm.FinalModel(t)
+
+	m, ok := fm.(modelTI)
+	if !ok {
+		t.Fatalf("final model have the wrong type: %T", fm)
+	}
+
+	assert.IsTypef(
+		t,
+		QuestionAnsweredMsg{},
+		m.CurrentMsg,
+		"expected type QuestionAnsweredMsg, got type %T",
+		m.CurrentMsg,
+	)
+	assert.Equal(t, Incorrect, m.QuestionComponent.QuestionStatus())
+	assert.NotContains(t, m.QuestionComponent.View(), "Try again")
+}
+
+func TestTypeInProgressiveReveal(t *testing.T) {
+	q := questions.TypeInLatToEngQuestion{TypeInLatToEngQuestion: &pb.TypeInLatToEngQuestion{
+		Prompt:     "prompt",
+		MainAnswer: "foo",
+		Answers:    []string{"foo"},
+	}}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{ProgressiveReveal: true}, &s)
+
+	m := modelTI{QuestionComponent: qc}
+	tm := teatest.NewTestModel(t, m, teatest.WithInitialTermSize(70, 30))
+	t.Cleanup(func() {
+		if err := tm.Quit(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// each incorrect answer should reveal another letter, instead of using up the only attempt
+	m.QuestionComponent.textinput.Focus()
+	tm.Type("qux")
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	time.Sleep(10 * time.Millisecond)
+
+	teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+		return bytes.Contains(bts, []byte(`Hint: starts with "f"`))
+	}, teatest.WithDuration(time.Second))
+
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	time.Sleep(10 * time.Millisecond)
+
+	for range len("qux") {
+		tm.Send(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	}
+
+	tm.Type("foo")
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	time.Sleep(10 * time.Millisecond)
+	tm.Quit()
+
+	fm := tm.FinalModel(t)
+
+	m, ok := fm.(modelTI)
+	if !ok {
+		t.Fatalf("final model have the wrong type: %T", fm)
+	}
+
+	assert.Equal(t, Correct, m.QuestionComponent.QuestionStatus())
+	assert.False(t, m.QuestionComponent.Hinted(), "revealed letters should not count as a hint")
+	assert.InDelta(t, 2.0/3, m.QuestionComponent.RevealedFraction(), 1e-9)
+}
+
+func TestTypeInProgressiveRevealExhausted(t *testing.T) {
+	q := questions.TypeInLatToEngQuestion{TypeInLatToEngQuestion: &pb.TypeInLatToEngQuestion{
+		Prompt:     "prompt",
+		MainAnswer: "foo",
+		Answers:    []string{"foo"},
+	}}
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	qc := NewTypeInQuestionModel(&q, 1, questions.MarkingOptions{ProgressiveReveal: true}, &s)
+
+	// the question is failed once revealing another letter would give the whole answer away
+	for range 2 {
+		qc.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+		assert.Equal(t, Unanswered, qc.QuestionStatus())
+	}
+
+	qc.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.Equal(t, Incorrect, qc.QuestionStatus())
+	assert.Contains(t, qc.View(), "✕ foo")
+}
@@ -0,0 +1,43 @@
+package questions
+
+import "strings"
+
+// leadingWords are the words that can be left out of (or added to) the start of an English answer when marking
+// leniently, as they rarely change its meaning, e.g. "the boy" and "boy".
+var leadingWords = []string{"a ", "an ", "the ", "i "}
+
+// MarkingOptions are the settings that change how responses are marked. These are only used by the client.
+type MarkingOptions struct {
+	RequireMacrons  bool // whether the macrons in the answer must be given too
+	LenientArticles bool // whether a leading article or "I" can be left out of English answers
+
+	// ProgressiveReveal is whether an incorrect answer to a type-in question reveals another letter of the answer
+	// and allows another try, instead of using up an attempt. The question is only worth part of its credit if any
+	// letters were revealed.
+	ProgressiveReveal bool
+}
+
+// stripLeadingWord returns s without a leading article or "I", ignoring case, so that "the boy" becomes "boy".
+func stripLeadingWord(s string) string {
+	for _, word := range leadingWords {
+		if len(s) > len(word) && strings.EqualFold(s[:len(word)], word) {
+			return strings.TrimSpace(s[len(word):])
+		}
+	}
+
+	return s
+}
+
+// matchesAnyIgnoringArticles reports whether response matches any of the answers once a leading article or "I" has
+// been stripped from each of them, ignoring macrons unless requireMacrons is true.
+func matchesAnyIgnoringArticles(answers []string, response string, requireMacrons bool) bool {
+	response = stripLeadingWord(response)
+
+	for _, answer := range answers {
+		if partMatches(stripLeadingWord(answer), response, requireMacrons) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,141 @@
+package endingcomponents
+
+import (
+	"fmt"
+	"strings"
+
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+)
+
+// abbreviations are the common abbreviations of the components, which can be given instead of their full names.
+// Ambiguous abbreviations (e.g. "imp" for imperfect or imperative, "sup" for supine or superlative) are left out.
+var abbreviations = map[string]ComponentSetter{
+	"nom": Case(pb.Case_CASE_NOMINATIVE),
+	"voc": Case(pb.Case_CASE_VOCATIVE),
+	"acc": Case(pb.Case_CASE_ACCUSATIVE),
+	"gen": Case(pb.Case_CASE_GENITIVE),
+	"dat": Case(pb.Case_CASE_DATIVE),
+	"abl": Case(pb.Case_CASE_ABLATIVE),
+
+	"sg":   Number(pb.Number_NUMBER_SINGULAR),
+	"sing": Number(pb.Number_NUMBER_SINGULAR),
+	"pl":   Number(pb.Number_NUMBER_PLURAL),
+	"plur": Number(pb.Number_NUMBER_PLURAL),
+
+	"m":    Gender(pb.Gender_GENDER_MASCULINE),
+	"masc": Gender(pb.Gender_GENDER_MASCULINE),
+	"f":    Gender(pb.Gender_GENDER_FEMININE),
+	"fem":  Gender(pb.Gender_GENDER_FEMININE),
+	"n":    Gender(pb.Gender_GENDER_NEUTER),
+	"neut": Gender(pb.Gender_GENDER_NEUTER),
+
+	"pres":     Tense(pb.Tense_TENSE_PRESENT),
+	"impf":     Tense(pb.Tense_TENSE_IMPERFECT),
+	"imperf":   Tense(pb.Tense_TENSE_IMPERFECT),
+	"fut":      Tense(pb.Tense_TENSE_FUTURE),
+	"perf":     Tense(pb.Tense_TENSE_PERFECT),
+	"plupf":    Tense(pb.Tense_TENSE_PLUPERFECT),
+	"pluperf":  Tense(pb.Tense_TENSE_PLUPERFECT),
+	"fut perf": Tense(pb.Tense_TENSE_FUTURE_PERFECT),
+	"futperf":  Tense(pb.Tense_TENSE_FUTURE_PERFECT),
+
+	"act":     Voice(pb.Voice_VOICE_ACTIVE),
+	"pass":    Voice(pb.Voice_VOICE_PASSIVE),
+	"dep":     Voice(pb.Voice_VOICE_DEPONENT),
+	"semidep": Voice(pb.Voice_VOICE_SEMI_DEPONENT),
+
+	"ind":   Mood(pb.Mood_MOOD_INDICATIVE),
+	"indic": Mood(pb.Mood_MOOD_INDICATIVE),
+	"subj":  Mood(pb.Mood_MOOD_SUBJUNCTIVE),
+	"impv":  Mood(pb.Mood_MOOD_IMPERATIVE),
+	"imper": Mood(pb.Mood_MOOD_IMPERATIVE),
+	"inf":   Mood(pb.Mood_MOOD_INFINITIVE),
+	"infin": Mood(pb.Mood_MOOD_INFINITIVE),
+	"ptcp":  Mood(pb.Mood_MOOD_PARTICIPLE),
+	"part":  Mood(pb.Mood_MOOD_PARTICIPLE),
+	"ger":   Mood(pb.Mood_MOOD_GERUND),
+
+	"1":   Person(pb.Person_PERSON_FIRST),
+	"1st": Person(pb.Person_PERSON_FIRST),
+	"2":   Person(pb.Person_PERSON_SECOND),
+	"2nd": Person(pb.Person_PERSON_SECOND),
+	"3":   Person(pb.Person_PERSON_THIRD),
+	"3rd": Person(pb.Person_PERSON_THIRD),
+
+	"pos":    Degree(pb.Degree_DEGREE_POSITIVE),
+	"comp":   Degree(pb.Degree_DEGREE_COMPARATIVE),
+	"superl": Degree(pb.Degree_DEGREE_SUPERLATIVE),
+}
+
+// components maps the full name (as returned by String) and any abbreviations of each component to the component.
+var components = func() map[string]ComponentSetter {
+	m := make(map[string]ComponentSetter, len(abbreviations))
+	for name, c := range abbreviations {
+		m[name] = c
+	}
+
+	addNames(m, Cases)
+	addNames(m, Numbers)
+	addNames(m, Genders)
+	addNames(m, Tenses)
+	addNames(m, Voices)
+	addNames(m, Moods)
+	addNames(m, Persons)
+	addNames(m, Degrees)
+
+	return m
+}()
+
+// namedComponent is a component that has a full name, as a Case, Number, etc. does.
+type namedComponent interface {
+	ComponentSetter
+	fmt.Stringer
+}
+
+// addNames adds the full name of each of the components to m.
+func addNames[T namedComponent](m map[string]ComponentSetter, cs []T) {
+	for _, c := range cs {
+		m[c.String()] = c
+	}
+}
+
+// Parse parses ending components written out as text, e.g. "dative singular neuter". Each component can be given by
+// its full name or a common abbreviation, in any order and case, so "dat sg n" is the same as the above.
+func Parse(s string) (*pb.EndingComponents, error) {
+	e := EndingComponents{EndingComponents: &pb.EndingComponents{}}
+	words := strings.Fields(strings.ToLower(strings.NewReplacer(".", " ", ",", " ").Replace(s)))
+	given := make(map[string]string) // type of each component given, to the word it was given by
+
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+
+		var (
+			c  ComponentSetter
+			ok bool
+		)
+
+		// components such as "future perfect" and "3rd person" are more than one word
+		if i+1 < len(words) {
+			if c, ok = components[word+" "+words[i+1]]; ok {
+				word += " " + words[i+1]
+				i++
+			}
+		}
+
+		if !ok {
+			if c, ok = components[word]; !ok {
+				return nil, fmt.Errorf("unknown ending component %q", word)
+			}
+		}
+
+		kind := fmt.Sprintf("%T", c)
+		if previous, ok := given[kind]; ok {
+			return nil, fmt.Errorf("ending component %q conflicts with %q", word, previous)
+		}
+
+		given[kind] = word
+		c.SetComponent(&e)
+	}
+
+	return e.EndingComponents, nil
+}
@@ -0,0 +1,90 @@
+package endingcomponents_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions/endingcomponents"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+)
+
+func TestParse(t *testing.T) {
+	dativeSingularNeuter := &pb.EndingComponents{
+		Case:   pb.Case_CASE_DATIVE,
+		Number: pb.Number_NUMBER_SINGULAR,
+		Gender: pb.Gender_GENDER_NEUTER,
+	}
+
+	tests := map[string]struct {
+		input string
+		want  *pb.EndingComponents
+	}{
+		"FullNames":     {input: "dative singular neuter", want: dativeSingularNeuter},
+		"Abbreviations": {input: "dat sg n", want: dativeSingularNeuter},
+		"Mixed":         {input: "dative sg neut", want: dativeSingularNeuter},
+		"AnyOrder":      {input: "n dat sg", want: dativeSingularNeuter},
+		"Punctuation":   {input: "Dat. Sg., N.", want: dativeSingularNeuter},
+		"Verb": {
+			input: "3rd person pl pres act subj",
+			want: &pb.EndingComponents{
+				Tense:  pb.Tense_TENSE_PRESENT,
+				Voice:  pb.Voice_VOICE_ACTIVE,
+				Mood:   pb.Mood_MOOD_SUBJUNCTIVE,
+				Person: pb.Person_PERSON_THIRD,
+				Number: pb.Number_NUMBER_PLURAL,
+			},
+		},
+		"MultipleWords": {
+			input: "future perfect passive infinitive",
+			want: &pb.EndingComponents{
+				Tense: pb.Tense_TENSE_FUTURE_PERFECT,
+				Voice: pb.Voice_VOICE_PASSIVE,
+				Mood:  pb.Mood_MOOD_INFINITIVE,
+			},
+		},
+		"AbbreviatedMultipleWords": {
+			input: "fut perf ind 1 sg semidep",
+			want: &pb.EndingComponents{
+				Tense:  pb.Tense_TENSE_FUTURE_PERFECT,
+				Voice:  pb.Voice_VOICE_SEMI_DEPONENT,
+				Mood:   pb.Mood_MOOD_INDICATIVE,
+				Person: pb.Person_PERSON_FIRST,
+				Number: pb.Number_NUMBER_SINGULAR,
+			},
+		},
+		"Adjective": {
+			input: "superl acc pl f",
+			want: &pb.EndingComponents{
+				Degree: pb.Degree_DEGREE_SUPERLATIVE,
+				Case:   pb.Case_CASE_ACCUSATIVE,
+				Number: pb.Number_NUMBER_PLURAL,
+				Gender: pb.Gender_GENDER_FEMININE,
+			},
+		},
+		"Empty": {input: "", want: &pb.EndingComponents{}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := endingcomponents.Parse(tt.input)
+			require.NoError(t, err)
+			assert.True(t, cmp.Equal(tt.want, got, protocmp.Transform()), cmp.Diff(tt.want, got, protocmp.Transform()))
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, input := range []string{
+		"dat sg x",     // unknown abbreviation
+		"imp act ind",  // ambiguous abbreviation
+		"dat abl sg n", // two cases
+		"sg singular",  // the same component twice
+	} {
+		_, err := endingcomponents.Parse(input)
+		assert.Error(t, err, input)
+	}
+}
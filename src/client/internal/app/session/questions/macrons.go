@@ -0,0 +1,76 @@
+package questions
+
+import (
+	"slices"
+	"strings"
+)
+
+// macronReplacer maps each Latin vowel with a macron (both lowercase and uppercase) to its base vowel.
+var macronReplacer = strings.NewReplacer(
+	"ā", "a", "ē", "e", "ī", "i", "ō", "o", "ū", "u", "ȳ", "y",
+	"Ā", "A", "Ē", "E", "Ī", "I", "Ō", "O", "Ū", "U", "Ȳ", "Y",
+)
+
+// NormalizeMacrons returns s with every macron stripped from its vowels, so that "praemiō" becomes "praemio".
+// All other characters are left untouched.
+func NormalizeMacrons(s string) string {
+	return macronReplacer.Replace(s)
+}
+
+// EqualIgnoringMacrons reports whether a and b are the same apart from any macrons.
+func EqualIgnoringMacrons(a, b string) bool {
+	return NormalizeMacrons(a) == NormalizeMacrons(b)
+}
+
+// matchesAny reports whether response matches any of the answers, ignoring macrons on both sides.
+func matchesAny(answers []string, response string) bool {
+	response = NormalizeMacrons(response)
+
+	for _, answer := range answers {
+		if NormalizeMacrons(answer) == response {
+			return true
+		}
+	}
+
+	return false
+}
+
+// partMatches reports whether a single part of a response matches the answer, ignoring macrons unless requireMacrons
+// is true.
+func partMatches(answer, response string, requireMacrons bool) bool {
+	if requireMacrons {
+		return answer == response
+	}
+
+	return NormalizeMacrons(answer) == NormalizeMacrons(response)
+}
+
+// CheckResponse reports whether the response is correct. With the default options, this is the same as
+// [Question.Check], which ignores macrons. If opts.RequireMacrons is set, the macrons in the answer must be given too,
+// and if opts.LenientArticles is set, a leading article or "I" is ignored in answers to Latin to English questions.
+func CheckResponse(q Question, response any, opts MarkingOptions) bool {
+	if q, ok := q.(*TypeInLatToEngQuestion); ok && opts.LenientArticles {
+		return matchesAnyIgnoringArticles(q.Answers, response.(string), opts.RequireMacrons)
+	}
+
+	if !q.Check(response) {
+		return false
+	}
+
+	if !opts.RequireMacrons {
+		return true
+	}
+
+	switch response := response.(type) {
+	case string:
+		answers := GetAllAnswers(q)
+		return answers == nil || slices.Contains(answers, response)
+
+	case []string:
+		if q, ok := q.(*PrincipalPartsQuestion); ok {
+			return slices.Equal(q.PrincipalParts, response)
+		}
+	}
+
+	return true
+}
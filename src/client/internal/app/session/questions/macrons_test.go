@@ -0,0 +1,32 @@
+package questions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeMacrons(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  string
+	}{
+		"NoMacrons":        {input: "praemio", want: "praemio"},
+		"SingleMacron":     {input: "praemiō", want: "praemio"},
+		"AllLowercase":     {input: "āēīōūȳ", want: "aeiouy"},
+		"AllUppercase":     {input: "ĀĒĪŌŪȲ", want: "AEIOUY"},
+		"MixedCase":        {input: "Rōma", want: "Roma"},
+		"MultipleMacrons":  {input: "amāvērunt", want: "amaverunt"},
+		"English":          {input: "by the boy", want: "by the boy"},
+		"OtherDiacritics":  {input: "naïve café", want: "naïve café"},
+		"NonLatinAlphabet": {input: "λόγος", want: "λόγος"},
+		"Empty":            {input: "", want: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NormalizeMacrons(tt.input)
+			assert.Equalf(t, tt.want, got, "expected %q, got %q (test %s)", tt.want, got, name)
+		})
+	}
+}
@@ -142,6 +142,78 @@ func TestCheck(t *testing.T) {
 			},
 			want: false,
 		},
+		"ParseWordLattoCompQuestion_Written": {
+			question: &questions.ParseWordLatToCompQuestion{&pb.ParseWordLatToCompQuestion{
+				Prompt:          "captae",
+				DictionaryEntry: "take: capio, capere, cepi, captus",
+				Answers: []*pb.EndingComponents{
+					{
+						Tense:  pb.Tense_TENSE_PERFECT,
+						Voice:  pb.Voice_VOICE_PASSIVE,
+						Mood:   pb.Mood_MOOD_PARTICIPLE,
+						Gender: pb.Gender_GENDER_FEMININE,
+						Case:   pb.Case_CASE_DATIVE,
+						Number: pb.Number_NUMBER_SINGULAR,
+					},
+				},
+			}},
+			input: "perfect passive participle dative singular feminine",
+			want:  true,
+		},
+		"ParseWordLattoCompQuestion_Abbreviated": {
+			question: &questions.ParseWordLatToCompQuestion{&pb.ParseWordLatToCompQuestion{
+				Prompt:          "captae",
+				DictionaryEntry: "take: capio, capere, cepi, captus",
+				Answers: []*pb.EndingComponents{
+					{
+						Tense:  pb.Tense_TENSE_PERFECT,
+						Voice:  pb.Voice_VOICE_PASSIVE,
+						Mood:   pb.Mood_MOOD_PARTICIPLE,
+						Gender: pb.Gender_GENDER_FEMININE,
+						Case:   pb.Case_CASE_DATIVE,
+						Number: pb.Number_NUMBER_SINGULAR,
+					},
+				},
+			}},
+			input: "perf pass ptcp dat sg f",
+			want:  true,
+		},
+		"ParseWordLattoCompQuestion_AbbreviatedIncorrect": {
+			question: &questions.ParseWordLatToCompQuestion{&pb.ParseWordLatToCompQuestion{
+				Prompt:          "captae",
+				DictionaryEntry: "take: capio, capere, cepi, captus",
+				Answers: []*pb.EndingComponents{
+					{
+						Tense:  pb.Tense_TENSE_PERFECT,
+						Voice:  pb.Voice_VOICE_PASSIVE,
+						Mood:   pb.Mood_MOOD_PARTICIPLE,
+						Gender: pb.Gender_GENDER_FEMININE,
+						Case:   pb.Case_CASE_DATIVE,
+						Number: pb.Number_NUMBER_SINGULAR,
+					},
+				},
+			}},
+			input: "perf pass ptcp abl sg f",
+			want:  false,
+		},
+		"ParseWordLattoCompQuestion_UnknownAbbreviation": {
+			question: &questions.ParseWordLatToCompQuestion{&pb.ParseWordLatToCompQuestion{
+				Prompt:          "captae",
+				DictionaryEntry: "take: capio, capere, cepi, captus",
+				Answers: []*pb.EndingComponents{
+					{
+						Tense:  pb.Tense_TENSE_PERFECT,
+						Voice:  pb.Voice_VOICE_PASSIVE,
+						Mood:   pb.Mood_MOOD_PARTICIPLE,
+						Gender: pb.Gender_GENDER_FEMININE,
+						Case:   pb.Case_CASE_DATIVE,
+						Number: pb.Number_NUMBER_SINGULAR,
+					},
+				},
+			}},
+			input: "perf pass ptcp dat sg fm",
+			want:  false,
+		},
 		"PrincipalPartsQuestion_1": {
 			question: &questions.PrincipalPartsQuestion{&pb.PrincipalPartsQuestion{
 				Prompt:         "ingens",
@@ -255,6 +327,67 @@ func TestCheck(t *testing.T) {
 			}},
 			input: "by means of those", want: false,
 		},
+		"MacronAnswer_TypeInEngtoLat": {
+			question: &questions.TypeInEngToLatQuestion{&pb.TypeInEngToLatQuestion{
+				Prompt:     "for the reward",
+				MainAnswer: "praemiō",
+				Answers:    []string{"praemiō"},
+			}},
+			input: "praemio", want: true,
+		},
+		"MacronResponse_TypeInEngtoLat": {
+			question: &questions.TypeInEngToLatQuestion{&pb.TypeInEngToLatQuestion{
+				Prompt:     "for the reward",
+				MainAnswer: "praemio",
+				Answers:    []string{"praemio"},
+			}},
+			input: "praemiō", want: true,
+		},
+		"MacronUppercase_TypeInEngtoLat": {
+			question: &questions.TypeInEngToLatQuestion{&pb.TypeInEngToLatQuestion{
+				Prompt:     "Rome",
+				MainAnswer: "Rōma",
+				Answers:    []string{"Rōma"},
+			}},
+			input: "Roma", want: true,
+		},
+		"MacronWrongWord_TypeInEngtoLat": {
+			question: &questions.TypeInEngToLatQuestion{&pb.TypeInEngToLatQuestion{
+				Prompt:     "for the reward",
+				MainAnswer: "praemiō",
+				Answers:    []string{"praemiō"},
+			}},
+			input: "praemia", want: false,
+		},
+		"MacronAnswer_ParseWordComptoLat": {
+			question: &questions.ParseWordCompToLatQuestion{
+				&pb.ParseWordCompToLatQuestion{
+					Prompt: "girl: puella, puellae, (f)",
+					Components: &pb.EndingComponents{
+						Case:   pb.Case_CASE_ABLATIVE,
+						Number: pb.Number_NUMBER_SINGULAR,
+					},
+					MainAnswer: "puellā",
+					Answers:    []string{"puellā"},
+				},
+			},
+			input: "puella", want: true,
+		},
+		"MacronAnswer_PrincipalParts": {
+			question: &questions.PrincipalPartsQuestion{&pb.PrincipalPartsQuestion{
+				Prompt:         "amo",
+				PrincipalParts: []string{"amō", "amāre", "amāvī", "amātus"},
+			}},
+			input: []string{"amo", "amare", "amavi", "amatus"}, want: true,
+		},
+		"MacronAnswer_MultipleChoiceEngtoLat": {
+			question: &questions.MultipleChoiceEngToLatQuestion{&pb.MultipleChoiceEngToLatQuestion{
+				Prompt:  "for the reward",
+				Choices: []string{"praemiō", "puer", "nomen"},
+				Answer:  "praemiō",
+			}},
+			input: "praemio", want: false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -516,3 +649,389 @@ func TestQuestionMode(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAllAnswers(t *testing.T) {
+	tests := map[string]struct {
+		question questions.Question
+		want     []string
+	}{
+		"MultipleChoiceEngToLatQuestion": {
+			question: &questions.MultipleChoiceEngToLatQuestion{&pb.MultipleChoiceEngToLatQuestion{
+				Prompt:  "that",
+				Choices: []string{"audio", "ille", "nomen"},
+				Answer:  "ille",
+			}},
+			want: []string{"ille"},
+		},
+		"MultipleChoiceLatToEngQuestion": {
+			question: &questions.MultipleChoiceLatToEngQuestion{&pb.MultipleChoiceLatToEngQuestion{
+				Prompt:  "puer",
+				Choices: []string{"name", "boy", "hear"},
+				Answer:  "boy",
+			}},
+			want: []string{"boy"},
+		},
+		"ParseWordCompToLatQuestion": {
+			question: &questions.ParseWordCompToLatQuestion{&pb.ParseWordCompToLatQuestion{
+				Prompt: "that: ille, illa, illud",
+				Components: &pb.EndingComponents{
+					Case:   pb.Case_CASE_DATIVE,
+					Number: pb.Number_NUMBER_SINGULAR,
+					Gender: pb.Gender_GENDER_NEUTER,
+				},
+				MainAnswer: "illi",
+				Answers:    []string{"illi"},
+			}},
+			want: []string{"illi"},
+		},
+		"ParseWordLatToCompQuestion": {
+			question: &questions.ParseWordLatToCompQuestion{&pb.ParseWordLatToCompQuestion{
+				Prompt:          "illi",
+				DictionaryEntry: "that: ille, illa, illud",
+				MainAnswer: &pb.EndingComponents{
+					Case:   pb.Case_CASE_DATIVE,
+					Number: pb.Number_NUMBER_SINGULAR,
+					Gender: pb.Gender_GENDER_NEUTER,
+				},
+			}},
+			want: nil,
+		},
+		"PrincipalPartsQuestion": {
+			question: &questions.PrincipalPartsQuestion{&pb.PrincipalPartsQuestion{
+				Prompt:         "ingens",
+				PrincipalParts: []string{"ingens", "ingentis"},
+			}},
+			want: []string{"ingens", "ingentis"},
+		},
+		"TypeInEngToLatQuestion": {
+			question: &questions.TypeInEngToLatQuestion{&pb.TypeInEngToLatQuestion{
+				Prompt:     "into",
+				MainAnswer: "in",
+				Answers:    []string{"in"},
+			}},
+			want: []string{"in"},
+		},
+		"TypeInLatToEngQuestion": {
+			question: &questions.TypeInLatToEngQuestion{&pb.TypeInLatToEngQuestion{
+				Prompt:     "ingenti",
+				MainAnswer: "large",
+				Answers:    []string{"large", "huge", "great"},
+			}},
+			want: []string{"large", "huge", "great"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := questions.GetAllAnswers(tt.question)
+			assert.Equal(t, tt.want, got, fmt.Sprintf("expected %v, got %v (test %s)", tt.want, got, name))
+		})
+	}
+}
+
+func TestGetComponents(t *testing.T) {
+	tests := map[string]struct {
+		question questions.Question
+		want     string
+		wantOK   bool
+	}{
+		"MultipleChoiceEngToLatQuestion": {
+			question: &questions.MultipleChoiceEngToLatQuestion{&pb.MultipleChoiceEngToLatQuestion{
+				Prompt:  "that",
+				Choices: []string{"audio", "ille", "nomen"},
+				Answer:  "ille",
+			}},
+		},
+		"MultipleChoiceLatToEngQuestion": {
+			question: &questions.MultipleChoiceLatToEngQuestion{&pb.MultipleChoiceLatToEngQuestion{
+				Prompt:  "puer",
+				Choices: []string{"name", "boy", "hear"},
+				Answer:  "boy",
+			}},
+		},
+		"ParseWordCompToLatQuestion": {
+			question: &questions.ParseWordCompToLatQuestion{&pb.ParseWordCompToLatQuestion{
+				Prompt: "that: ille, illa, illud",
+				Components: &pb.EndingComponents{
+					Case:          pb.Case_CASE_DATIVE,
+					Number:        pb.Number_NUMBER_SINGULAR,
+					Gender:        pb.Gender_GENDER_NEUTER,
+					DisplayString: "dative singular neuter",
+				},
+				MainAnswer: "illi",
+				Answers:    []string{"illi"},
+			}},
+			want:   "dative singular neuter",
+			wantOK: true,
+		},
+		"ParseWordLatToCompQuestion": {
+			question: &questions.ParseWordLatToCompQuestion{&pb.ParseWordLatToCompQuestion{
+				Prompt:          "illi",
+				DictionaryEntry: "that: ille, illa, illud",
+				MainAnswer: &pb.EndingComponents{
+					Case:   pb.Case_CASE_DATIVE,
+					Number: pb.Number_NUMBER_SINGULAR,
+					Gender: pb.Gender_GENDER_NEUTER,
+				},
+			}},
+			want:   "that: ille, illa, illud",
+			wantOK: true,
+		},
+		"PrincipalPartsQuestion": {
+			question: &questions.PrincipalPartsQuestion{&pb.PrincipalPartsQuestion{
+				Prompt:         "ingens",
+				PrincipalParts: []string{"ingens", "ingentis"},
+			}},
+		},
+		"TypeInEngToLatQuestion": {
+			question: &questions.TypeInEngToLatQuestion{&pb.TypeInEngToLatQuestion{
+				Prompt:     "into",
+				MainAnswer: "in",
+				Answers:    []string{"in"},
+			}},
+		},
+		"TypeInLatToEngQuestion": {
+			question: &questions.TypeInLatToEngQuestion{&pb.TypeInLatToEngQuestion{
+				Prompt:     "ingenti",
+				MainAnswer: "large",
+				Answers:    []string{"large", "huge", "great"},
+			}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := questions.GetComponents(tt.question)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
+
+func TestCheckPartial(t *testing.T) {
+	principalParts := &questions.PrincipalPartsQuestion{&pb.PrincipalPartsQuestion{
+		Prompt:         "audio",
+		PrincipalParts: []string{"audio", "audire", "audivi", "auditus"},
+	}}
+
+	tests := map[string]struct {
+		question       questions.Question
+		input          any
+		requireMacrons bool
+		wantCorrect    int
+		wantTotal      int
+	}{
+		"PrincipalPartsQuestion_AllCorrect": {
+			question:    principalParts,
+			input:       []string{"audio", "audire", "audivi", "auditus"},
+			wantCorrect: 4, wantTotal: 4,
+		},
+		"PrincipalPartsQuestion_SomeCorrect": {
+			question:    principalParts,
+			input:       []string{"audio", "audere", "audivi", "audatus"},
+			wantCorrect: 2, wantTotal: 4,
+		},
+		"PrincipalPartsQuestion_NoneCorrect": {
+			question:    principalParts,
+			input:       []string{"", "", "", ""},
+			wantCorrect: 0, wantTotal: 4,
+		},
+		"PrincipalPartsQuestion_Macrons": {
+			question:    principalParts,
+			input:       []string{"audiō", "audīre", "audīvī", "wrong"},
+			wantCorrect: 3, wantTotal: 4,
+		},
+		"PrincipalPartsQuestion_RequireMacrons": {
+			question: &questions.PrincipalPartsQuestion{&pb.PrincipalPartsQuestion{
+				Prompt:         "amo",
+				PrincipalParts: []string{"amō", "amāre", "amāvī", "amātus"},
+			}},
+			input:          []string{"amō", "amare", "amāvī", "amatus"},
+			requireMacrons: true,
+			wantCorrect:    2, wantTotal: 4,
+		},
+		"TypeInLatToEngQuestion_Correct": {
+			question: &questions.TypeInLatToEngQuestion{&pb.TypeInLatToEngQuestion{
+				Prompt:     "ingenti",
+				MainAnswer: "large",
+				Answers:    []string{"large", "huge", "great"},
+			}},
+			input:       "huge",
+			wantCorrect: 1, wantTotal: 1,
+		},
+		"TypeInLatToEngQuestion_Incorrect": {
+			question: &questions.TypeInLatToEngQuestion{&pb.TypeInLatToEngQuestion{
+				Prompt:     "ingenti",
+				MainAnswer: "large",
+				Answers:    []string{"large", "huge", "great"},
+			}},
+			input:       "small",
+			wantCorrect: 0, wantTotal: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotCorrect, gotTotal := questions.CheckPartial(
+				tt.question,
+				tt.input,
+				questions.MarkingOptions{RequireMacrons: tt.requireMacrons},
+			)
+			assert.Equalf(t, tt.wantCorrect, gotCorrect, "expected %d correct (test %s)", tt.wantCorrect, name)
+			assert.Equalf(t, tt.wantTotal, gotTotal, "expected %d total (test %s)", tt.wantTotal, name)
+		})
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	typeIn := &questions.TypeInEngToLatQuestion{&pb.TypeInEngToLatQuestion{
+		Prompt:     "for the reward",
+		MainAnswer: "praemiō",
+		Answers:    []string{"praemiō", "praemiīs"},
+	}}
+	principalParts := &questions.PrincipalPartsQuestion{&pb.PrincipalPartsQuestion{
+		Prompt:         "amo",
+		PrincipalParts: []string{"amō", "amāre", "amāvī", "amātus"},
+	}}
+	latToEng := &questions.TypeInLatToEngQuestion{&pb.TypeInLatToEngQuestion{
+		Prompt:     "puer",
+		MainAnswer: "boy",
+		Answers:    []string{"boy", "the boy"},
+	}}
+	firstPerson := &questions.TypeInLatToEngQuestion{&pb.TypeInLatToEngQuestion{
+		Prompt:     "amo",
+		MainAnswer: "I love",
+		Answers:    []string{"I love", "I am loving"},
+	}}
+
+	tests := map[string]struct {
+		question        questions.Question
+		input           any
+		requireMacrons  bool
+		lenientArticles bool
+		want            bool
+	}{
+		"TypeIn_MacronsIgnored":           {question: typeIn, input: "praemio", want: true},
+		"TypeIn_MacronsRequired":          {question: typeIn, input: "praemio", requireMacrons: true, want: false},
+		"TypeIn_MacronsGiven":             {question: typeIn, input: "praemiīs", requireMacrons: true, want: true},
+		"TypeIn_WrongWordMacronsRequired": {question: typeIn, input: "praemia", requireMacrons: true, want: false},
+		"PrincipalParts_MacronsIgnored": {
+			question: principalParts,
+			input:    []string{"amo", "amare", "amavi", "amatus"},
+			want:     true,
+		},
+		"PrincipalParts_MacronsRequired": {
+			question:       principalParts,
+			input:          []string{"amō", "amāre", "amavi", "amātus"},
+			requireMacrons: true,
+			want:           false,
+		},
+		"PrincipalParts_MacronsGiven": {
+			question:       principalParts,
+			input:          []string{"amō", "amāre", "amāvī", "amātus"},
+			requireMacrons: true,
+			want:           true,
+		},
+		"LatToEng_ArticleStrict":         {question: latToEng, input: "a boy", want: false},
+		"LatToEng_TheLenient":            {question: latToEng, input: "the boy", lenientArticles: true, want: true},
+		"LatToEng_NoArticleLenient":      {question: latToEng, input: "boy", lenientArticles: true, want: true},
+		"LatToEng_ALenient":              {question: latToEng, input: "a boy", lenientArticles: true, want: true},
+		"LatToEng_CapitalisedLenient":    {question: latToEng, input: "The boy", lenientArticles: true, want: true},
+		"LatToEng_WrongWordLenient":      {question: latToEng, input: "the girl", lenientArticles: true, want: false},
+		"LatToEng_PronounStrict":         {question: firstPerson, input: "love", want: false},
+		"LatToEng_PronounLenient":        {question: firstPerson, input: "love", lenientArticles: true, want: true},
+		"TypeIn_LatinArticleNotStripped": {question: typeIn, input: "a praemio", lenientArticles: true, want: false},
+		"LatToEng_OnlyFirstWordStripped": {
+			question:        firstPerson,
+			input:           "I the love",
+			lenientArticles: true,
+			want:            false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := questions.CheckResponse(
+				tt.question,
+				tt.input,
+				questions.MarkingOptions{RequireMacrons: tt.requireMacrons, LenientArticles: tt.lenientArticles},
+			)
+			assert.Equal(t, tt.want, got, fmt.Sprintf("expected %t, got %t (test %s)", tt.want, got, name))
+		})
+	}
+}
+
+func TestQuestionModeString(t *testing.T) {
+	tests := map[questions.QuestionMode]string{
+		questions.Regular:          "regular",
+		questions.PrincipalParts:   "principal_parts",
+		questions.MultipleChoice:   "multiple_choice",
+		questions.ParseWord:        "parse_word",
+		questions.QuestionMode(99): "QuestionMode(99)",
+	}
+
+	for mode, want := range tests {
+		assert.Equal(t, want, mode.String())
+	}
+}
+
+func TestParseQuestionMode(t *testing.T) {
+	tests := map[string]questions.QuestionMode{
+		"regular":         questions.Regular,
+		"principal_parts": questions.PrincipalParts,
+		"multiple_choice": questions.MultipleChoice,
+		"parse_word":      questions.ParseWord,
+	}
+
+	for name, want := range tests {
+		got, err := questions.ParseQuestionMode(name)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	for _, name := range []string{"", "MultipleChoice", "QuestionMode(99)"} {
+		_, err := questions.ParseQuestionMode(name)
+		assert.ErrorContains(t, err, fmt.Sprintf("unknown question mode %q", name))
+	}
+}
+
+func TestToProto(t *testing.T) {
+	tests := map[string]questions.Question{
+		"MultipleChoiceEngToLatQuestion": &questions.MultipleChoiceEngToLatQuestion{
+			&pb.MultipleChoiceEngToLatQuestion{Prompt: "that", Answer: "ille", Choices: []string{"audio", "ille"}},
+		},
+		"MultipleChoiceLatToEngQuestion": &questions.MultipleChoiceLatToEngQuestion{
+			&pb.MultipleChoiceLatToEngQuestion{Prompt: "ille", Answer: "that", Choices: []string{"hear", "that"}},
+		},
+		"ParseWordCompToLatQuestion": &questions.ParseWordCompToLatQuestion{&pb.ParseWordCompToLatQuestion{
+			Prompt:     "puer",
+			MainAnswer: "puerum",
+			Answers:    []string{"puerum"},
+		}},
+		"ParseWordLatToCompQuestion": &questions.ParseWordLatToCompQuestion{&pb.ParseWordLatToCompQuestion{
+			Prompt:          "puerum",
+			DictionaryEntry: "boy: puer, pueri, (m)",
+			MainAnswer:      &pb.EndingComponents{Case: pb.Case_CASE_ACCUSATIVE, Number: pb.Number_NUMBER_SINGULAR},
+		}},
+		"PrincipalPartsQuestion": &questions.PrincipalPartsQuestion{&pb.PrincipalPartsQuestion{
+			Prompt:         "capio",
+			PrincipalParts: []string{"capio", "capere", "cepi", "captus"},
+		}},
+		"TypeInEngToLatQuestion": &questions.TypeInEngToLatQuestion{&pb.TypeInEngToLatQuestion{
+			Prompt:     "boy",
+			MainAnswer: "puer",
+			Answers:    []string{"puer"},
+		}},
+		"TypeInLatToEngQuestion": &questions.TypeInLatToEngQuestion{&pb.TypeInLatToEngQuestion{
+			Prompt:     "puer",
+			MainAnswer: "boy",
+			Answers:    []string{"boy"},
+		}},
+	}
+
+	for name, question := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, question, questions.NewQuestion(questions.ToProto(question)))
+		})
+	}
+}
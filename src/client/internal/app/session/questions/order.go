@@ -0,0 +1,48 @@
+package questions
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+)
+
+// Order is the order that questions are asked in.
+type Order int
+
+//go:generate go tool stringer -type=Order -linecomment -output=order_gen.go
+const (
+	OrderServer Order = iota // server
+	OrderRandom              // random
+	OrderByType              // by-type
+)
+
+// ParseOrder returns the order with the given name, as returned by [Order.String].
+func ParseOrder(name string) (Order, error) {
+	for order := OrderServer; order <= OrderByType; order++ {
+		if order.String() == name {
+			return order, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown question order %q", name)
+}
+
+// Reorder puts the questions into the given order. rng is used to shuffle the questions if the order is
+// [OrderRandom], and may be nil otherwise. Questions of the same mode keep their order when ordered by type.
+func (qs Questions) Reorder(order Order, rng *rand.Rand) {
+	switch order {
+	case OrderServer:
+		// already in the order they were generated in
+
+	case OrderRandom:
+		rng.Shuffle(len(qs), func(i, j int) {
+			qs[i], qs[j] = qs[j], qs[i]
+		})
+
+	case OrderByType:
+		slices.SortStableFunc(qs, func(a, b Question) int {
+			return cmp.Compare(a.QuestionMode(), b.QuestionMode())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package questions_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+)
+
+// orderTestQuestions returns questions of mixed modes, in the order the server might send them.
+func orderTestQuestions() questions.Questions {
+	return questions.Questions{
+		&questions.TypeInEngToLatQuestion{&pb.TypeInEngToLatQuestion{Prompt: "boy"}},
+		&questions.MultipleChoiceEngToLatQuestion{&pb.MultipleChoiceEngToLatQuestion{Prompt: "that"}},
+		&questions.PrincipalPartsQuestion{&pb.PrincipalPartsQuestion{Prompt: "amo"}},
+		&questions.TypeInLatToEngQuestion{&pb.TypeInLatToEngQuestion{Prompt: "puella"}},
+		&questions.MultipleChoiceLatToEngQuestion{&pb.MultipleChoiceLatToEngQuestion{Prompt: "puer"}},
+		&questions.PrincipalPartsQuestion{&pb.PrincipalPartsQuestion{Prompt: "moneo"}},
+	}
+}
+
+func prompts(qs questions.Questions) []string {
+	ps := make([]string, len(qs))
+	for i, q := range qs {
+		ps[i] = q.GetPrompt()
+	}
+
+	return ps
+}
+
+func TestReorder(t *testing.T) {
+	tests := map[string]struct {
+		order questions.Order
+		want  []string
+	}{
+		"Server": {
+			order: questions.OrderServer,
+			want:  []string{"boy", "that", "amo", "puella", "puer", "moneo"},
+		},
+		"Random": {
+			order: questions.OrderRandom,
+			want:  []string{"puella", "that", "amo", "puer", "boy", "moneo"},
+		},
+		"ByType": {
+			order: questions.OrderByType,
+			want:  []string{"boy", "puella", "amo", "moneo", "that", "puer"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			qs := orderTestQuestions()
+			qs.Reorder(tt.order, rand.New(rand.NewPCG(1, 1)))
+
+			assert.Equal(t, tt.want, prompts(qs))
+		})
+	}
+}
+
+func TestReorderRandomIsSeeded(t *testing.T) {
+	a, b := orderTestQuestions(), orderTestQuestions()
+	a.Reorder(questions.OrderRandom, rand.New(rand.NewPCG(42, 42)))
+	b.Reorder(questions.OrderRandom, rand.New(rand.NewPCG(42, 42)))
+
+	assert.Equal(t, prompts(a), prompts(b))
+	assert.ElementsMatch(t, prompts(orderTestQuestions()), prompts(a))
+}
+
+func TestParseOrder(t *testing.T) {
+	for _, order := range []questions.Order{questions.OrderServer, questions.OrderRandom, questions.OrderByType} {
+		got, err := questions.ParseOrder(order.String())
+		require.NoError(t, err)
+		assert.Equal(t, order, got)
+	}
+
+	_, err := questions.ParseOrder("alphabetical")
+	assert.Error(t, err)
+}
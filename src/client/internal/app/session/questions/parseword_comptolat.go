@@ -1,10 +1,6 @@
 package questions
 
-import (
-	"slices"
-
-	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
-)
+import pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
 
 type ParseWordCompToLatQuestion struct {
 	*pb.ParseWordCompToLatQuestion
@@ -19,7 +15,7 @@ func (q *ParseWordCompToLatQuestion) GetPrompt() string {
 }
 
 func (q *ParseWordCompToLatQuestion) Check(response any) bool {
-	return slices.Contains(q.Answers, response.(string))
+	return matchesAny(q.Answers, response.(string))
 }
 
 func (q *ParseWordCompToLatQuestion) GetMainAnswer() any {
@@ -4,6 +4,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
 
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions/endingcomponents"
 	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
 )
 
@@ -19,8 +20,21 @@ func (q *ParseWordLatToCompQuestion) GetPrompt() string {
 	return q.Prompt
 }
 
+// Check reports whether the response is correct. The response is a *pb.EndingComponents, or a string of the
+// components written out, which can be abbreviated (see [endingcomponents.Parse]).
 func (q *ParseWordLatToCompQuestion) Check(response any) bool {
-	responseComp := response.(*pb.EndingComponents)
+	var responseComp *pb.EndingComponents
+
+	switch response := response.(type) {
+	case *pb.EndingComponents:
+		responseComp = response
+
+	case string:
+		var err error
+		if responseComp, err = endingcomponents.Parse(response); err != nil {
+			return false
+		}
+	}
 
 	for _, ans := range q.Answers {
 		if cmp.Equal(ans, responseComp,
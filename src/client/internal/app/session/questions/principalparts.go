@@ -19,7 +19,9 @@ func (q *PrincipalPartsQuestion) GetPrompt() string {
 }
 
 func (q *PrincipalPartsQuestion) Check(response any) bool {
-	return slices.Equal(q.PrincipalParts, response.([]string))
+	return slices.EqualFunc(q.PrincipalParts, response.([]string), func(part, resp string) bool {
+		return NormalizeMacrons(part) == NormalizeMacrons(resp)
+	})
 }
 
 func (q *PrincipalPartsQuestion) GetMainAnswer() any {
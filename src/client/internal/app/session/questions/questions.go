@@ -1,16 +1,32 @@
 package questions
 
-import pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+import (
+	"fmt"
+
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+)
 
 type QuestionMode int
 
+//go:generate go tool stringer -type=QuestionMode -linecomment -output=question_mode_gen.go
 const (
-	Regular QuestionMode = iota
-	PrincipalParts
-	MultipleChoice
-	ParseWord
+	Regular        QuestionMode = iota // regular
+	PrincipalParts                     // principal_parts
+	MultipleChoice                     // multiple_choice
+	ParseWord                          // parse_word
 )
 
+// ParseQuestionMode returns the question mode with the given name, as returned by [QuestionMode.String].
+func ParseQuestionMode(name string) (QuestionMode, error) {
+	for mode := Regular; mode <= ParseWord; mode++ {
+		if mode.String() == name {
+			return mode, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown question mode %q", name)
+}
+
 type (
 	Questions []Question
 	Question  interface {
@@ -34,6 +50,69 @@ type (
 	}
 )
 
+// GetAllAnswers returns every accepted answer to a question, or nil if its answers cannot be given as strings.
+// Multiple choice questions have a single accepted answer, and the answer to a principal parts question is the
+// principal parts themselves.
+func GetAllAnswers(q Question) []string {
+	switch q := q.(type) {
+	case *MultipleChoiceEngToLatQuestion:
+		return []string{q.Answer}
+
+	case *MultipleChoiceLatToEngQuestion:
+		return []string{q.Answer}
+
+	case *PrincipalPartsQuestion:
+		return q.PrincipalParts
+
+	case *TypeInEngToLatQuestion:
+		return q.Answers
+
+	case *TypeInLatToEngQuestion:
+		return q.Answers
+
+	case *ParseWordCompToLatQuestion:
+		return q.Answers
+	}
+
+	return nil
+}
+
+// GetComponents returns the context given alongside the prompt of a parse question: the ending components to give
+// the form for, or the dictionary entry that the form to be parsed comes from. ok is false for any other question.
+func GetComponents(q Question) (components string, ok bool) {
+	switch q := q.(type) {
+	case *ParseWordCompToLatQuestion:
+		return q.GetComponents().GetDisplayString(), true
+
+	case *ParseWordLatToCompQuestion:
+		return q.DictionaryEntry, true
+	}
+
+	return "", false
+}
+
+// CheckPartial reports how much of the response is correct, as the number of correct parts out of the total number
+// of parts. Principal parts questions are marked part by part, and any other question is a single part, marked with
+// [CheckResponse].
+func CheckPartial(q Question, response any, opts MarkingOptions) (correct, total int) {
+	if q, ok := q.(*PrincipalPartsQuestion); ok && len(q.PrincipalParts) > 0 {
+		parts := response.([]string)
+		for i, part := range q.PrincipalParts {
+			if i < len(parts) && partMatches(part, parts[i], opts.RequireMacrons) {
+				correct++
+			}
+		}
+
+		return correct, len(q.PrincipalParts)
+	}
+
+	if CheckResponse(q, response, opts) {
+		return 1, 1
+	}
+
+	return 0, 1
+}
+
 func NewQuestion(q *pb.Question) Question {
 	if v := q.GetMcEngToLat(); v != nil {
 		return &MultipleChoiceEngToLatQuestion{v}
@@ -65,3 +144,31 @@ func NewQuestion(q *pb.Question) Question {
 
 	return nil
 }
+
+// ToProto returns the question as it is sent by the server, the inverse of [NewQuestion].
+func ToProto(q Question) *pb.Question {
+	switch q := q.(type) {
+	case *MultipleChoiceEngToLatQuestion:
+		return &pb.Question{Kind: &pb.Question_McEngToLat{McEngToLat: q.MultipleChoiceEngToLatQuestion}}
+
+	case *MultipleChoiceLatToEngQuestion:
+		return &pb.Question{Kind: &pb.Question_McLatToEng{McLatToEng: q.MultipleChoiceLatToEngQuestion}}
+
+	case *ParseWordCompToLatQuestion:
+		return &pb.Question{Kind: &pb.Question_ParseCompToLat{ParseCompToLat: q.ParseWordCompToLatQuestion}}
+
+	case *ParseWordLatToCompQuestion:
+		return &pb.Question{Kind: &pb.Question_ParseLatToComp{ParseLatToComp: q.ParseWordLatToCompQuestion}}
+
+	case *PrincipalPartsQuestion:
+		return &pb.Question{Kind: &pb.Question_PrincipalParts{PrincipalParts: q.PrincipalPartsQuestion}}
+
+	case *TypeInEngToLatQuestion:
+		return &pb.Question{Kind: &pb.Question_TypeInEngToLat{TypeInEngToLat: q.TypeInEngToLatQuestion}}
+
+	case *TypeInLatToEngQuestion:
+		return &pb.Question{Kind: &pb.Question_TypeInLatToEng{TypeInLatToEng: q.TypeInLatToEngQuestion}}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package questions
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+)
+
+// FieldSchema describes a field of a question as it appears in JSON.
+type FieldSchema struct {
+	Name     string        `json:"name"`
+	Type     string        `json:"type"` // protobuf kind of the field, e.g. "string", "message" or "enum"
+	Repeated bool          `json:"repeated,omitzero"`
+	Values   []string      `json:"values,omitempty"` // names of the values of an enum field
+	Fields   []FieldSchema `json:"fields,omitempty"` // fields of a message field
+}
+
+// VariantSchema describes one kind of question that the server can send.
+type VariantSchema struct {
+	Key     string        `json:"key"` // key of the question object that holds this kind of question
+	Message string        `json:"message"`
+	Fields  []FieldSchema `json:"fields"`
+}
+
+// Schema describes every kind of question that the server can send, in the order they are defined, as they appear
+// in JSON. A question is an object with exactly one of the keys, e.g. a questions file is a JSON array of these.
+func Schema() []VariantSchema {
+	kind := (&pb.Question{}).ProtoReflect().Descriptor().Oneofs().ByName("kind")
+	fields := kind.Fields()
+
+	variants := make([]VariantSchema, fields.Len())
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		variants[i] = VariantSchema{
+			Key:     fd.JSONName(),
+			Message: string(fd.Message().Name()),
+			Fields:  messageSchema(fd.Message()),
+		}
+	}
+
+	return variants
+}
+
+// messageSchema describes the fields of a message, in the order they are defined.
+func messageSchema(md protoreflect.MessageDescriptor) []FieldSchema {
+	fields := md.Fields()
+
+	schema := make([]FieldSchema, fields.Len())
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		schema[i] = FieldSchema{
+			Name:     fd.JSONName(),
+			Type:     fd.Kind().String(),
+			Repeated: fd.Cardinality() == protoreflect.Repeated,
+		}
+
+		switch fd.Kind() {
+		case protoreflect.EnumKind:
+			values := fd.Enum().Values()
+			for j := range values.Len() {
+				schema[i].Values = append(schema[i].Values, string(values.Get(j).Name()))
+			}
+
+		case protoreflect.MessageKind:
+			schema[i].Fields = messageSchema(fd.Message())
+		}
+	}
+
+	return schema
+}
@@ -0,0 +1,43 @@
+package questions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+)
+
+func TestSchema(t *testing.T) {
+	schema := questions.Schema()
+
+	keys := make([]string, len(schema))
+	for i, variant := range schema {
+		keys[i] = variant.Key
+	}
+
+	assert.Equal(t, []string{
+		"mcEngToLat",
+		"mcLatToEng",
+		"parseCompToLat",
+		"parseLatToComp",
+		"principalParts",
+		"typeInEngToLat",
+		"typeInLatToEng",
+	}, keys)
+
+	assert.Equal(t, "TypeInEngToLatQuestion", schema[5].Message)
+	assert.Equal(t, []questions.FieldSchema{
+		{Name: "answers", Type: "string", Repeated: true},
+		{Name: "mainAnswer", Type: "string"},
+		{Name: "prompt", Type: "string"},
+	}, schema[5].Fields)
+
+	// message fields are described too, down to the values of their enums
+	components := schema[2].Fields[1]
+	assert.Equal(t, "components", components.Name)
+	assert.Equal(t, "message", components.Type)
+	assert.NotEmpty(t, components.Fields)
+	assert.Equal(t, "enum", components.Fields[0].Type)
+	assert.Contains(t, components.Fields[0].Values, "CASE_NOMINATIVE")
+}
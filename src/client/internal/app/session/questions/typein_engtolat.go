@@ -1,10 +1,6 @@
 package questions
 
-import (
-	"slices"
-
-	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
-)
+import pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
 
 type TypeInEngToLatQuestion struct {
 	*pb.TypeInEngToLatQuestion
@@ -19,7 +15,7 @@ func (q *TypeInEngToLatQuestion) GetPrompt() string {
 }
 
 func (q *TypeInEngToLatQuestion) Check(response any) bool {
-	return slices.Contains(q.Answers, response.(string))
+	return matchesAny(q.Answers, response.(string))
 }
 
 func (q *TypeInEngToLatQuestion) GetMainAnswer() any {
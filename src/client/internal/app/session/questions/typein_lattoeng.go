@@ -1,10 +1,6 @@
 package questions
 
-import (
-	"slices"
-
-	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
-)
+import pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
 
 type TypeInLatToEngQuestion struct {
 	*pb.TypeInLatToEngQuestion
@@ -19,7 +15,7 @@ func (q *TypeInLatToEngQuestion) GetPrompt() string {
 }
 
 func (q *TypeInLatToEngQuestion) Check(response any) bool {
-	return slices.Contains(q.Answers, response.(string))
+	return matchesAny(q.Answers, response.(string))
 }
 
 func (q *TypeInLatToEngQuestion) GetMainAnswer() any {
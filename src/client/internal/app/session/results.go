@@ -0,0 +1,53 @@
+package session
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"fmt"
+	"os"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/stats"
+)
+
+// results returns the summary of the session to save to the results file.
+func (m *Model) results() *stats.Results {
+	questions := make([]stats.QuestionResult, len(m.history))
+	for i, q := range m.history {
+		questions[i] = stats.QuestionResult{
+			Prompt:       q.prompt,
+			MainAnswer:   q.answer,
+			Response:     q.response,
+			Correct:      q.correct,
+			Hinted:       q.hinted,
+			GaveUp:       q.gaveUp,
+			QuestionMode: questionModeName(q.mode),
+		}
+	}
+
+	return &stats.Results{
+		Score:      m.score,
+		Answered:   m.answeredCount,
+		Correct:    m.correctCount,
+		Skipped:    m.skippedCount,
+		DurationMs: m.sessionDuration.Milliseconds(),
+		Questions:  questions,
+	}
+}
+
+func saveResults(filePath string, results *stats.Results) tea.Cmd {
+	return func() tea.Msg {
+		data, err := json.Marshal(results, jsontext.WithIndent("  "))
+		if err != nil {
+			return app.ErrMsg(fmt.Errorf("failed to marshal session results: %w", err))
+		}
+
+		if err := os.WriteFile(filePath, data, 0o644); err != nil {
+			return app.ErrMsg(fmt.Errorf("failed to save session results to %s: %w", filePath, err))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,102 @@
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/exp/teatest/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/stats"
+)
+
+func TestRetryKeepsResults(t *testing.T) {
+	dir := t.TempDir()
+	resultsPath := filepath.Join(dir, "results.json")
+	markedPath := filepath.Join(dir, "marked.txt")
+
+	m := newTestSession(t, Options{ResultsPath: resultsPath, MarkedPath: markedPath}, questions.Questions{
+		typeIn("puer", "boy"),
+		typeIn("puella", "girl"),
+	})
+
+	m = runSession(t, m, func(tm *teatest.TestModel) {
+		waitForOutput(t, tm, "puer")
+		answerTypeIn(tm, "boy")
+		answerTypeIn(tm, "dog")
+		waitForOutput(t, tm, "Retry 1 incorrect questions?")
+
+		tm.Type("y")
+		time.Sleep(100 * time.Millisecond)
+		answerTypeIn(tm, "girl")
+		time.Sleep(100 * time.Millisecond)
+	})
+
+	assert.True(t, m.retrying)
+	assert.Equal(t, Completed, m.appStatus)
+
+	results, err := stats.ReadResults(resultsPath)
+	require.NoError(t, err)
+	assert.Equal(t, 2, results.Answered)
+	assert.Equal(t, 1, results.Correct)
+	assert.Len(t, results.Questions, 2)
+
+	_, err = os.Stat(markedPath)
+	assert.NoError(t, err)
+}
+
+func TestRetryCountExcludesRestoredQuestions(t *testing.T) {
+	q := typeIn("puella", "girl")
+	m := newTestSession(t, Options{}, questions.Questions{q})
+
+	// the first question was restored from a checkpoint, so only its prompt is known
+	m.history = []answeredQuestion{
+		{prompt: "puer", answer: "boy", response: "dog"},
+		{prompt: "puella", answer: "girl", response: "cat", question: q},
+	}
+	m.appStatus = Completed
+
+	view := m.View()
+	assert.Contains(t, view, "Retry 1 incorrect questions?")
+	assert.NotContains(t, view, "Retry 2")
+}
+
+func TestSaveResultsRoundTrip(t *testing.T) {
+	want := &stats.Results{
+		Score:      1.5,
+		Answered:   3,
+		Correct:    1,
+		Skipped:    1,
+		DurationMs: 61500,
+		Questions: []stats.QuestionResult{
+			{Prompt: "puer", MainAnswer: "boy", Response: "boy", Correct: true, QuestionMode: "Regular"},
+			{Prompt: "puella", MainAnswer: "girl", Response: "", GaveUp: true, QuestionMode: "Regular"},
+			{
+				Prompt:       "amō",
+				MainAnswer:   "amō, amāre, amāvī, amātus",
+				Response:     "amo, amare, amavit, amatum",
+				Hinted:       true,
+				QuestionMode: "Principal parts",
+			},
+		},
+	}
+
+	path := filepath.Join(t.TempDir(), "results.json")
+	require.Nil(t, saveResults(path, want)())
+
+	got, err := stats.ReadResults(path)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestSaveResultsUnwritable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "results.json")
+
+	msg := saveResults(path, &stats.Results{})()
+	assert.ErrorContains(t, msg.(app.ErrMsg), "failed to save session results to "+path)
+}
@@ -0,0 +1,278 @@
+package session
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/exp/teatest/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/components/navigator"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
+)
+
+type model struct {
+	Session *Model
+	focused navigator.Navigable
+}
+
+func (m model) Init() tea.Cmd {
+	return m.Session.Init()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// focus components as the navigator on the root page would
+	if msg, ok := msg.(navigator.FocusNavigableMsg); ok {
+		if m.focused != nil {
+			m.focused.Blur()
+		}
+
+		m.focused = msg.Target
+		m.focused.Focus()
+	}
+
+	_, cmd := m.Session.Update(msg)
+
+	return m, cmd
+}
+
+func (m model) View() tea.View {
+	return tea.NewView(m.Session.View())
+}
+
+func typeIn(prompt string, answers ...string) questions.Question {
+	return &questions.TypeInLatToEngQuestion{TypeInLatToEngQuestion: &pb.TypeInLatToEngQuestion{
+		Prompt:     prompt,
+		MainAnswer: answers[0],
+		Answers:    answers,
+	}}
+}
+
+// newTestSession returns a session that has started on the first of qs, as if they had been read from a questions
+// file.
+func newTestSession(t *testing.T, options Options, qs questions.Questions) *Model {
+	t.Helper()
+
+	s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+	status := create.StatusVerified
+	marking := questions.MarkingOptions{}
+
+	if options.QuestionsPath == "" {
+		options.QuestionsPath = "questions.json" // never read, as the questions are given directly
+	}
+
+	if options.Attempts == 0 {
+		options.Attempts = 1
+	}
+
+	options.StaticSpinner = true
+	options.HideTimers = true
+
+	m := New(&status, &status, app.ServerOptions{}, options, nil, nil, nil, &marking, &s)
+	m.SetWidth(100)
+	m.SetHeight(40)
+	m.Update(QuestionStreamGetMsg{QuestionProvider: &SliceQuestionProvider{questions: qs}, reordered: true})
+
+	if m.appStatus != Initialised {
+		t.Fatalf("session did not start: status %s", m.appStatus)
+	}
+
+	return m
+}
+
+// runSession runs the session in a test program, with answer called to answer its questions.
+func runSession(t *testing.T, m *Model, answer func(tm *teatest.TestModel)) *Model {
+	t.Helper()
+
+	tm := teatest.NewTestModel(t, model{Session: m}, teatest.WithInitialTermSize(100, 40))
+	answer(tm)
+
+	if err := tm.Quit(); err != nil {
+		t.Fatal(err)
+	}
+
+	return tm.FinalModel(t, teatest.WithFinalTimeout(time.Second)).(model).Session
+}
+
+// answerTypeIn answers the current type-in question with response, then moves on to the next question.
+func answerTypeIn(tm *teatest.TestModel, response string) {
+	tm.Type(response)
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	time.Sleep(50 * time.Millisecond)
+	tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+	time.Sleep(50 * time.Millisecond)
+}
+
+// waitForOutput waits until the output of the test program contains s.
+func waitForOutput(t *testing.T, tm *teatest.TestModel, s string) {
+	t.Helper()
+
+	teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+		return bytes.Contains(bts, []byte(s))
+	}, teatest.WithDuration(3*time.Second))
+}
+
+func TestTestingSessionStatusString(t *testing.T) {
+	tests := map[testingSessionStatus]string{
+		Unavailable:              "Unavailable",
+		Uninitialised:            "Uninitialised",
+		Initialised:              "Initialised",
+		Completed:                "Completed",
+		testingSessionStatus(99): "testingSessionStatus(99)",
+	}
+
+	for status, want := range tests {
+		assert.Equal(t, want, status.String())
+	}
+}
+
+func TestIncorrectFeedback(t *testing.T) {
+	tests := map[string]struct {
+		bell     bool
+		response string
+		wantBell bool
+	}{
+		"Incorrect":       {bell: true, response: "dog", wantBell: true},
+		"IncorrectNoBell": {bell: false, response: "dog"},
+		"Correct":         {bell: true, response: "boy"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newTestSession(t, Options{Bell: tt.bell}, questions.Questions{typeIn("puer", "boy")})
+
+			tm := teatest.NewTestModel(t, model{Session: m}, teatest.WithInitialTermSize(100, 40))
+			waitForOutput(t, tm, "puer")
+			tm.Type(tt.response)
+			tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+			time.Sleep(50 * time.Millisecond)
+
+			require.NoError(t, tm.Quit())
+
+			out, err := io.ReadAll(tm.FinalOutput(t, teatest.WithFinalTimeout(time.Second)))
+			require.NoError(t, err)
+
+			m = tm.FinalModel(t).(model).Session
+			require.Len(t, m.history, 1)
+
+			assert.Equal(t, tt.wantBell, bytes.Contains(out, []byte("\a")), "bell rang")
+			assert.Equal(t, tt.wantBell, m.flashID > 0, "border flashed")
+		})
+	}
+}
+
+func TestStreak(t *testing.T) {
+	qs := questions.Questions{
+		typeIn("puer", "boy"),
+		typeIn("puella", "girl"),
+		typeIn("servus", "slave"),
+		typeIn("dominus", "master"),
+	}
+
+	m := runSession(t, newTestSession(t, Options{}, qs), func(tm *teatest.TestModel) {
+		waitForOutput(t, tm, "puer")
+		answerTypeIn(tm, "boy")
+		answerTypeIn(tm, "girl")
+		answerTypeIn(tm, "dog")
+		answerTypeIn(tm, "master")
+		waitForOutput(t, tm, "Best streak: 2")
+	})
+
+	assert.Equal(t, Completed, m.appStatus)
+	assert.Equal(t, 1, m.streak)
+	assert.Equal(t, 2, m.bestStreak)
+}
+
+func TestPreviousQuestion(t *testing.T) {
+	qs := questions.Questions{typeIn("puer", "boy"), typeIn("puella", "girl"), typeIn("servus", "slave")}
+
+	m := runSession(t, newTestSession(t, Options{}, qs), func(tm *teatest.TestModel) {
+		waitForOutput(t, tm, "puer")
+		answerTypeIn(tm, "boy")
+
+		tm.Type("dog")
+		tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+		time.Sleep(50 * time.Millisecond)
+
+		// look back at the first question, then go forward again, past the second one
+		tm.Send(tea.KeyPressMsg{Code: 'p', Mod: tea.ModCtrl})
+		waitForOutput(t, tm, "Answered question 1/2")
+		tm.Send(tea.KeyPressMsg{Code: tea.KeyDown})
+		tm.Send(tea.KeyPressMsg{Code: tea.KeyDown})
+		time.Sleep(50 * time.Millisecond)
+
+		tm.Send(tea.KeyPressMsg{Code: tea.KeyEnter})
+		time.Sleep(50 * time.Millisecond)
+		answerTypeIn(tm, "slave")
+		waitForOutput(t, tm, "Best streak: 1")
+	})
+
+	assert.Equal(t, Completed, m.appStatus)
+	assert.False(t, m.viewingHistory)
+	assert.Len(t, m.history, 3)
+	assert.Equal(t, 2, m.correctCount)
+	assert.InDelta(t, 2, m.score, 1e-9)
+}
+
+func TestSkipQuestion(t *testing.T) {
+	qs := questions.Questions{typeIn("puer", "boy"), typeIn("puella", "girl"), typeIn("servus", "slave")}
+
+	m := runSession(t, newTestSession(t, Options{}, qs), func(tm *teatest.TestModel) {
+		waitForOutput(t, tm, "puer")
+		answerTypeIn(tm, "boy")
+		waitForOutput(t, tm, "puella")
+		tm.Send(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+		waitForOutput(t, tm, "servus")
+	})
+
+	assert.Equal(t, Initialised, m.appStatus)
+	assert.Equal(t, 1, m.skippedCount)
+	assert.Equal(t, 1, m.correctCount)
+	assert.InDelta(t, 1, m.score, 1e-9)
+	assert.Len(t, m.history, 1, "skipped question recorded as answered")
+	assert.Equal(t, "Score: 1/1 (100.0%), 1 skipped", m.scoreText())
+
+	// the next question is loaded straight away
+	assert.Equal(t, "servus", m.currentQuestion.GetPrompt())
+	assert.Equal(t, 3, m.questionProvider.Current())
+	assert.True(t, m.previousSkipped)
+	assert.Contains(t, m.View(), "(previous question skipped)")
+}
+
+func TestConfirmQuit(t *testing.T) {
+	tests := map[string]struct {
+		key      tea.KeyPressMsg
+		wantQuit bool
+	}{
+		"Confirm": {key: tea.KeyPressMsg{Code: 'y', Text: "y"}, wantQuit: true},
+		"Cancel":  {key: tea.KeyPressMsg{Code: 'n', Text: "n"}},
+		"Other":   {key: tea.KeyPressMsg{Code: tea.KeyEnter}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newTestSession(t, Options{}, questions.Questions{typeIn("puer", "boy")})
+			require.True(t, m.ConfirmQuit())
+			assert.False(t, m.ConfirmQuit(), "already asking to confirm quitting")
+
+			_, cmd := m.Update(tt.key)
+
+			if tt.wantQuit {
+				require.NotNil(t, cmd)
+				assert.Equal(t, tea.QuitMsg{}, cmd())
+			} else {
+				assert.Nil(t, cmd)
+				assert.False(t, m.confirmingQuit, "still asking to confirm quitting")
+				assert.Equal(t, Initialised, m.appStatus)
+			}
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package session
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/spinner"
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
@@ -18,15 +21,339 @@ import (
 	"github.com/rduo1009/vocab-tuister/src/client/internal/util"
 )
 
+// timerTickMsg is sent roughly once per second to update the question and session timers.
+type timerTickMsg struct{ id int }
+
+// timerTick schedules the next tick of the timers.
+func (m *Model) timerTick() tea.Cmd {
+	id := m.timerTickID
+
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return timerTickMsg{id: id}
+	})
+}
+
+// startTimerTicks starts a new chain of ticks for the timers, making any older chain stale.
+func (m *Model) startTimerTicks() tea.Cmd {
+	m.timerTickID++
+	return m.timerTick()
+}
+
+// startQuestionTimer resets the question timer for a newly shown question.
+func (m *Model) startQuestionTimer() tea.Cmd {
+	m.questionStart = time.Now()
+	m.questionElapsed = 0
+
+	return m.startTimerTicks()
+}
+
+// startSpinner starts the loading spinner spinning, unless it is kept still by Options.StaticSpinner.
+func (m *Model) startSpinner() tea.Cmd {
+	if m.options.StaticSpinner {
+		return nil
+	}
+
+	return m.loadingSpinner.Tick
+}
+
+// flashEndMsg is sent once the border has been flashed for long enough.
+type flashEndMsg struct{ id int }
+
+// flashDuration is how long the border is flashed for after an incorrect answer.
+const flashDuration = 300 * time.Millisecond
+
+// incorrectFeedback rings the terminal bell and flashes the border, if enabled by [Options.Bell].
+func (m *Model) incorrectFeedback() tea.Cmd {
+	if !m.options.Bell {
+		return nil
+	}
+
+	m.flashing = true
+	m.flashID++
+	id := m.flashID
+
+	return tea.Batch(
+		tea.Raw("\a"),
+		tea.Tick(flashDuration, func(time.Time) tea.Msg {
+			return flashEndMsg{id: id}
+		}),
+	)
+}
+
+// questionTime returns how long the current question has been shown for, not counting any time spent being asked to
+// confirm quitting.
+func (m *Model) questionTime() time.Duration {
+	if m.confirmingQuit {
+		return m.quitPromptStart.Sub(m.questionStart)
+	}
+
+	return time.Since(m.questionStart)
+}
+
+// revealDue reports whether the current question has gone unanswered for long enough that its answer should be
+// revealed, as set by [Options.RevealAfter].
+func (m *Model) revealDue() bool {
+	return m.options.RevealAfter > 0 && !m.dropdownActive && !m.confirmingQuit &&
+		m.currentQuestionModel.QuestionStatus() == questioncomponents.Unanswered &&
+		m.questionTime() >= m.options.RevealAfter
+}
+
+// newQuestionModel creates the question component matching the mode of q, with any keys remapped by the user.
+func (m *Model) newQuestionModel(q questions.Question) questioncomponents.QuestionModel {
+	var qm questioncomponents.QuestionModel
+
+	switch q.QuestionMode() {
+	case questions.Regular:
+		qm = questioncomponents.NewTypeInQuestionModel(q, m.options.Attempts, *m.marking, m.styles)
+
+	case questions.ParseWord:
+		qm = questioncomponents.NewParseQuestionModel(q, m.styles)
+
+	case questions.PrincipalParts:
+		qm = questioncomponents.NewPrincipalPartsQuestionModel(q, m.options.Attempts, *m.marking, m.styles)
+
+	case questions.MultipleChoice:
+		qm = questioncomponents.NewMultipleChoiceQuestionModel(q, m.rng, m.styles)
+
+	default:
+		panic("unsupported question mode: " + q.QuestionMode().String())
+	}
+
+	qm.RemapKeys(m.options.KeyOverrides)
+
+	return qm
+}
+
+// nextQuestion moves on to the next question, or completes the session if there are no questions left.
+func (m *Model) nextQuestion() tea.Cmd {
+	if m.questionProvider.Current() >= m.questionProvider.Total() {
+		m.appStatus = Completed
+		m.sessionDuration = time.Since(m.sessionStart)
+
+		navigables := []navigator.Navigable{m.returnButton, m.restartButton}
+		if len(m.missedQuestions()) > 0 {
+			navigables = append(navigables, m.reviewButton)
+		}
+
+		cmd := tea.Sequence(
+			util.MsgCmd(navigator.AddNavigableMsg{Components: navigables}),
+			util.MsgCmd(navigator.FocusNavigableMsg{Target: m.returnButton}),
+		)
+		// the results are of the original round, so they are not overwritten with those of a retry
+		if m.options.ResultsPath != "" && !m.retrying {
+			cmd = tea.Batch(cmd, saveResults(m.options.ResultsPath, m.results()))
+		}
+
+		// questions marked during a retry are added to those already marked, so these are saved again
+		if m.options.MarkedPath != "" {
+			cmd = tea.Batch(cmd, saveMarked(m.options.MarkedPath, m.marked))
+		}
+
+		if m.checkpointPath != "" {
+			cmd = tea.Batch(cmd, removeCheckpoint(m.checkpointPath))
+		}
+
+		return cmd
+	}
+
+	requested := m.questionProvider.Total()
+
+	q, err := m.questionProvider.Next()
+	if errors.Is(err, errQuestionsExhausted) {
+		if !m.options.AllowFewer {
+			return util.MsgCmd(app.ErrMsg(tooFewQuestionsError(requested, m.questionProvider.Total())))
+		}
+
+		// the total now matches the questions provided, so this completes the session
+		m.questionsRequested = requested
+
+		return m.nextQuestion()
+	} else if err != nil {
+		return util.MsgCmd(app.ErrMsg(err))
+	}
+
+	m.currentQuestion = q
+	m.currentQuestionModel = m.newQuestionModel(q)
+
+	return tea.Batch(m.currentQuestionModel.Init(), m.startQuestionTimer())
+}
+
+// missedQuestions returns the questions that were answered incorrectly.
+func (m *Model) missedQuestions() []answeredQuestion {
+	var missed []answeredQuestion
+
+	for _, q := range m.history {
+		if !q.correct {
+			missed = append(missed, q)
+		}
+	}
+
+	return missed
+}
+
+// retryableQuestions returns the questions that were answered incorrectly and can be retried, which excludes any
+// restored from a checkpoint.
+func (m *Model) retryableQuestions() questions.Questions {
+	var retryable questions.Questions
+
+	for _, q := range m.missedQuestions() {
+		if q.question != nil {
+			retryable = append(retryable, q.question)
+		}
+	}
+
+	return retryable
+}
+
+// offerRetry reports whether the user should be offered to retry the incorrectly answered questions.
+func (m *Model) offerRetry() bool {
+	return m.appStatus == Completed && !m.retryDeclined && len(m.retryableQuestions()) > 0
+}
+
+// credit returns the credit awarded for the current question, which is only partial if some principal parts are
+// correct, or if letters of the answer had to be revealed before it was answered correctly.
+func (m *Model) credit(correct bool) float64 {
+	if q, ok := m.currentQuestionModel.(*questioncomponents.PrincipalPartsQuestionModel); ok {
+		partsCorrect, total := questions.CheckPartial(m.currentQuestion, q.Responses(), *m.marking)
+		return float64(partsCorrect) / float64(total)
+	}
+
+	if q, ok := m.currentQuestionModel.(*questioncomponents.TypeInQuestionModel); ok && correct {
+		return 1 - q.RevealedFraction()
+	}
+
+	if correct {
+		return 1
+	}
+
+	return 0
+}
+
+// reset clears the state of the session so that it can be started again.
+func (m *Model) reset() {
+	m.appStatus = Unavailable
+	m.answeredCount = 0
+	m.correctCount = 0
+	m.score = 0
+	m.skippedCount = 0
+	m.hintsUsed = 0
+	m.gaveUpCount = 0
+	m.previousSkipped = false
+	m.streak = 0
+	m.bestStreak = 0
+	clear(m.scoreByMode)
+	m.history = nil
+	m.marked = nil
+	m.viewingHistory = false
+	m.reviewing = false
+	m.reviewIndex = 0
+	m.sessionStart = time.Time{}
+	m.sessionDuration = 0
+	m.retrying = false
+	m.retryDeclined = false
+	m.firstRoundCorrect = 0
+	m.firstRoundAnswered = 0
+	m.checkpointPath = ""
+	m.pendingCheckpoint = nil
+	m.questionOffset = 0
+	m.resumedElapsed = 0
+	m.questionsRequested = 0
+}
+
+// retryMissed starts a new round of the session made up of the incorrectly answered questions.
+func (m *Model) retryMissed() tea.Cmd {
+	retryQuestions := m.retryableQuestions()
+
+	navigables := []navigator.Navigable{m.returnButton, m.restartButton, m.reviewButton}
+
+	// only keep the score of the original round, not of any earlier retries
+	if !m.retrying {
+		m.firstRoundCorrect = m.correctCount
+		m.firstRoundAnswered = m.answeredCount
+	}
+
+	m.questionProvider.Close()
+	m.questionProvider = &SliceQuestionProvider{questions: retryQuestions}
+
+	m.answeredCount = 0
+	m.correctCount = 0
+	m.score = 0
+	m.skippedCount = 0
+	m.hintsUsed = 0
+	m.gaveUpCount = 0
+	m.previousSkipped = false
+	m.streak = 0
+	m.bestStreak = 0
+	clear(m.scoreByMode)
+	m.history = nil
+	m.reviewing = false
+	m.retrying = true
+	m.appStatus = Initialised
+	m.sessionStart = time.Now()
+
+	return tea.Sequence(
+		util.MsgCmd(navigator.RemoveNavigableMsg{Components: navigables}),
+		m.nextQuestion(),
+	)
+}
+
+// checkpoint saves the state of the session so that it can be resumed if interrupted. Retries are not checkpointed.
+func (m *Model) checkpoint() tea.Cmd {
+	if m.checkpointPath == "" || m.retrying {
+		return nil
+	}
+
+	return saveCheckpoint(m.checkpointPath, m.newCheckpoint())
+}
+
+// ConfirmQuit asks the user to confirm quitting if a session is in progress, so that it is not lost by accident.
+func (m *Model) ConfirmQuit() bool {
+	if m.appStatus != Initialised || m.confirmingQuit {
+		return false
+	}
+
+	m.confirmingQuit = true
+	m.quitPromptStart = time.Now()
+
+	return true
+}
+
 func (m *Model) Update(msg tea.Msg) (app.PageModel, tea.Cmd) {
 	var cmds []tea.Cmd
+
+	if msg, ok := msg.(tea.KeyPressMsg); ok && m.confirmingQuit {
+		// any key other than the confirm key cancels quitting
+		if key.Matches(msg, m.KeyMap().(confirmQuitKeyMap).Confirm) {
+			return m, tea.Quit
+		}
+
+		// the question timer is paused while the user is asked
+		m.confirmingQuit = false
+		m.questionStart = m.questionStart.Add(time.Since(m.quitPromptStart))
+
+		return m, nil
+	}
+
 	switch m.appStatus {
 	case Unavailable:
-		if *m.listVerified == create.StatusVerified && *m.configVerified == create.StatusVerified {
+		if m.options.QuestionsPath != "" {
+			// offline, so the list and config don't need to be verified by the server
 			m.appStatus = Uninitialised
 			cmds = append(
 				cmds,
-				getQuestions(m.serverPort, *m.vocabList, *m.sessionConfig, *m.numberOfQuestions),
+				readQuestionsFile(m.options.QuestionsPath),
+				m.startSpinner(),
+				util.MsgCmd(navigator.RemoveNavigableMsg{
+					Components: []navigator.Navigable{m.returnButton},
+				}),
+			)
+		} else if *m.listVerified == create.StatusVerified && *m.configVerified == create.StatusVerified {
+			m.appStatus = Uninitialised
+			m.checkpointPath = checkpointPath(*m.vocabList, *m.sessionConfig, *m.numberOfQuestions)
+			cmds = append(
+				cmds,
+				loadCheckpoint(m.checkpointPath),
+				m.startSpinner(),
 				util.MsgCmd(navigator.RemoveNavigableMsg{
 					Components: []navigator.Navigable{m.returnButton},
 				}),
@@ -36,9 +363,7 @@ func (m *Model) Update(msg tea.Msg) (app.PageModel, tea.Cmd) {
 				key.Matches(msg, m.KeyMap().(unavailableKeyMap).PressButton) &&
 				m.returnButton.Focused() {
 				// set up returning back later
-				m.appStatus = Unavailable
-				m.answeredCount = 0
-				m.correctCount = 0
+				m.reset()
 
 				// return to create page
 				return m, tea.Batch(
@@ -53,77 +378,204 @@ func (m *Model) Update(msg tea.Msg) (app.PageModel, tea.Cmd) {
 		fallthrough
 
 	case Uninitialised:
+		switch msg := msg.(type) {
+		case spinner.TickMsg:
+			// the spinner stops once the questions have loaded, as ticks are no longer passed on to it
+			var cmd tea.Cmd
+			m.loadingSpinner, cmd = m.loadingSpinner.Update(msg)
+			cmds = append(cmds, cmd)
+
+		case checkpointLoadedMsg:
+			if cp := msg.checkpoint; cp != nil && cp.QuestionsDone < cp.Total {
+				m.pendingCheckpoint = cp
+				break
+			}
+
+			cmds = append(cmds, getQuestions(m.server, *m.vocabList, *m.sessionConfig, *m.numberOfQuestions))
+
+		case tea.KeyPressMsg:
+			if m.pendingCheckpoint == nil {
+				break
+			}
+
+			keys := m.KeyMap().(resumeKeyMap)
+
+			switch {
+			case key.Matches(msg, keys.Resume):
+				cp := m.pendingCheckpoint
+				m.pendingCheckpoint = nil
+				m.restoreCheckpoint(cp)
+				cmds = append(cmds, getQuestions(m.server, *m.vocabList, *m.sessionConfig, cp.Total-cp.QuestionsDone))
+
+			case key.Matches(msg, keys.StartOver):
+				m.pendingCheckpoint = nil
+				cmds = append(
+					cmds,
+					removeCheckpoint(m.checkpointPath),
+					getQuestions(m.server, *m.vocabList, *m.sessionConfig, *m.numberOfQuestions),
+				)
+			}
+		}
+
 		if msg, ok := msg.(QuestionStreamGetMsg); ok {
+			// the questions are all received up front if they need reordering, and streamed otherwise
+			reorder := m.options.HistoryPath != "" || m.options.Order != questions.OrderServer
+			if reorder && !msg.reordered {
+				cmds = append(cmds, reorderQuestions(
+					msg.QuestionProvider,
+					m.options.Order,
+					m.orderRNG,
+					m.options.HistoryPath,
+					m.options.AllowFewer,
+				))
+
+				break
+			}
+
 			m.questionProvider = msg.QuestionProvider
+			m.questionsRequested = msg.requested
+			if m.questionOffset > 0 {
+				m.questionProvider = &offsetQuestionProvider{
+					QuestionProvider: msg.QuestionProvider,
+					offset:           m.questionOffset,
+				}
+			}
 
 			q, err := m.questionProvider.Next()
-			if err != nil {
+			if errors.Is(err, errQuestionsExhausted) {
+				cmds = append(cmds, util.MsgCmd(app.ErrMsg(errNoQuestions)))
+				break
+			} else if err != nil {
 				cmds = append(cmds, util.MsgCmd(app.ErrMsg(err)))
 				break
 			}
 
-			switch q.QuestionMode() {
-			case questions.Regular:
-				m.currentQuestionModel = questioncomponents.NewTypeInQuestionModel(q, m.styles)
+			m.currentQuestion = q
+			m.currentQuestionModel = m.newQuestionModel(q)
+			m.appStatus = Initialised
+			m.sessionStart = time.Now().Add(-m.resumedElapsed)
+			cmds = append(cmds, m.currentQuestionModel.Init(), m.startQuestionTimer())
+		}
+
+	case Initialised:
+		if _, ok := msg.(tea.KeyPressMsg); ok {
+			m.showHelpHint = false
+		}
+
+		if msg, ok := msg.(tea.KeyPressMsg); ok && m.viewingHistory {
+			keys := m.KeyMap().(reviewKeyMap)
 
-			case questions.ParseWord:
-				m.currentQuestionModel = questioncomponents.NewParseQuestionModel(q, m.styles)
+			switch {
+			case key.Matches(msg, keys.Previous):
+				m.historyIndex = max(m.historyIndex-1, 0)
 
-			case questions.PrincipalParts:
-				m.currentQuestionModel = questioncomponents.NewPrincipalPartsQuestionModel(q, m.styles)
+			case key.Matches(msg, keys.Next):
+				// moving forward past the last question returns to the current question
+				m.historyIndex++
+				m.viewingHistory = m.historyIndex < len(m.history)-1
 
-			case questions.MultipleChoice:
-				m.currentQuestionModel = questioncomponents.NewMultipleChoiceQuestionModel(q, m.styles)
+			case key.Matches(msg, keys.Close):
+				m.viewingHistory = false
 			}
 
-			m.appStatus = Initialised
-			cmds = append(cmds, m.currentQuestionModel.Init())
+			return m, nil
+		}
+
+		if msg, ok := msg.(tea.KeyPressMsg); ok && !m.dropdownActive &&
+			key.Matches(msg, m.KeyMap().(questionKeyMap).Mark) {
+			m.toggleMarked()
+			return m, nil
+		}
+
+		if msg, ok := msg.(tea.KeyPressMsg); ok && !m.dropdownActive &&
+			key.Matches(msg, m.KeyMap().(questionKeyMap).Previous) {
+			// the current question is the last in the history, so only look back if there is an earlier one
+			if len(m.history) > 1 {
+				m.viewingHistory = true
+				m.historyIndex = len(m.history) - 2
+			}
+
+			return m, nil
 		}
 
-	case Initialised:
 		switch msg := msg.(type) {
+		case timerTickMsg:
+			// keep ticking for the session timer, unless a newer chain of ticks has started
+			if msg.id != m.timerTickID {
+				return m, nil
+			}
+
+			if m.revealDue() {
+				return m, tea.Batch(m.timerTick(), m.currentQuestionModel.GiveUp())
+			}
+
+			return m, m.timerTick()
+
 		case questioncomponents.QuestionAnsweredMsg:
+			m.questionElapsed = m.questionTime()
 			m.answeredCount++
-			if m.currentQuestionModel.QuestionStatus() == questioncomponents.Correct {
+			score := m.scoreByMode[m.currentQuestion.QuestionMode()]
+			score.total++
+
+			correct := m.currentQuestionModel.QuestionStatus() == questioncomponents.Correct
+			if correct {
 				m.correctCount++
+				score.correct++
+				m.streak++
+				m.bestStreak = max(m.bestStreak, m.streak)
+			} else {
+				m.streak = 0
 			}
 
-		case questioncomponents.NextQuestionMsg:
-			if m.questionProvider.Current() >= *m.numberOfQuestions {
-				m.appStatus = Completed
-
-				return m, tea.Sequence(
-					util.MsgCmd(navigator.AddNavigableMsg{
-						Components: []navigator.Navigable{
-							m.returnButton,
-							m.restartButton,
-						},
-					}),
-					util.MsgCmd(navigator.FocusNavigableMsg{Target: m.returnButton}),
-				)
+			if !correct && !msg.GaveUp {
+				cmds = append(cmds, m.incorrectFeedback())
 			}
 
-			q, err := m.questionProvider.Next()
-			if err != nil {
-				cmds = append(cmds, util.MsgCmd(app.ErrMsg(err)))
-				break
+			response := m.currentQuestionModel.Response()
+			if msg.GaveUp {
+				// anything already typed is not an answer, so no partial credit is given for it
+				response = ""
+				m.gaveUpCount++
+			} else {
+				m.score += m.credit(correct)
+			}
+
+			var hinted bool
+			if q, ok := m.currentQuestionModel.(*questioncomponents.TypeInQuestionModel); ok && q.Hinted() {
+				hinted = true
+				m.hintsUsed++
 			}
 
-			switch q.QuestionMode() {
-			case questions.Regular:
-				m.currentQuestionModel = questioncomponents.NewTypeInQuestionModel(q, m.styles)
+			m.history = append(m.history, answeredQuestion{
+				prompt:   m.currentQuestion.GetPrompt(),
+				response: response,
+				answer:   formatAnswer(m.currentQuestion.GetMainAnswer()),
+				correct:  correct,
+				hinted:   hinted,
+				gaveUp:   msg.GaveUp,
+				mode:     m.currentQuestion.QuestionMode(),
+				question: m.currentQuestion,
+			})
+
+			m.scoreByMode[m.currentQuestion.QuestionMode()] = score
+			cmds = append(cmds, m.checkpoint())
+
+		case flashEndMsg:
+			if msg.id == m.flashID {
+				m.flashing = false
+			}
 
-			case questions.ParseWord:
-				m.currentQuestionModel = questioncomponents.NewParseQuestionModel(q, m.styles)
+			return m, nil
 
-			case questions.PrincipalParts:
-				m.currentQuestionModel = questioncomponents.NewPrincipalPartsQuestionModel(q, m.styles)
+		case questioncomponents.NextQuestionMsg:
+			m.previousSkipped = false
+			return m, m.nextQuestion()
 
-			case questions.MultipleChoice:
-				m.currentQuestionModel = questioncomponents.NewMultipleChoiceQuestionModel(q, m.styles)
-			}
+		case questioncomponents.QuestionSkippedMsg:
+			m.skippedCount++
+			m.previousSkipped = true
 
-			return m, m.currentQuestionModel.Init()
+			return m, tea.Sequence(m.checkpoint(), m.nextQuestion())
 
 		case dropdown.StartMsg:
 			if strings.HasPrefix(msg.ID, "parsequestionDropdown") {
@@ -164,22 +616,56 @@ func (m *Model) Update(msg tea.Msg) (app.PageModel, tea.Cmd) {
 		}
 
 	case Completed:
+		if msg, ok := msg.(tea.KeyPressMsg); ok && m.reviewing {
+			keys := m.KeyMap().(reviewKeyMap)
+
+			switch {
+			case key.Matches(msg, keys.Previous):
+				m.reviewIndex = max(m.reviewIndex-1, 0)
+
+			case key.Matches(msg, keys.Next):
+				m.reviewIndex = min(m.reviewIndex+1, len(m.missedQuestions())-1)
+
+			case key.Matches(msg, keys.Close):
+				m.reviewing = false
+			}
+
+			break
+		}
+
+		if msg, ok := msg.(tea.KeyPressMsg); ok && m.offerRetry() {
+			switch {
+			case key.Matches(msg, m.KeyMap().(completedKeyMap).Retry):
+				return m, m.retryMissed()
+
+			case key.Matches(msg, m.KeyMap().(completedKeyMap).DeclineRetry):
+				m.retryDeclined = true
+				return m, nil
+			}
+		}
+
 		if msg, ok := msg.(tea.KeyPressMsg); ok && key.Matches(msg, m.KeyMap().(completedKeyMap).PressButton) {
 			switch {
+			case m.reviewButton.Focused():
+				m.reviewing = true
+				m.reviewIndex = 0
+
 			case m.returnButton.Focused():
 				// set up returning back later
-				m.appStatus = Unavailable
-				m.answeredCount = 0
-				m.correctCount = 0
+				m.reset()
 				m.questionProvider.Close()
 
 				// return to create page; no need to remove navigables as this will be done anyway
 				return m, util.MsgCmd(tabs.SelectTabMsg{Index: 0})
 
 			case m.restartButton.Focused():
-				m.appStatus = Unavailable
-				m.answeredCount = 0
-				m.correctCount = 0
+				if len(m.missedQuestions()) > 0 {
+					cmds = append(cmds, util.MsgCmd(navigator.RemoveNavigableMsg{
+						Components: []navigator.Navigable{m.reviewButton},
+					}))
+				}
+
+				m.reset()
 				m.questionProvider.Close()
 
 				cmds = append(cmds, m.Init())
@@ -2,10 +2,16 @@ package session
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	"charm.land/lipgloss/v2"
 
 	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questioncomponents"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	pb "github.com/rduo1009/vocab-tuister/src/client/internal/pb/vocab_tuister/v1"
 )
 
 func (m *Model) SetWidth(width int) {
@@ -37,6 +43,214 @@ func (m *Model) OverlayView(width, height int) (view string, x, y int) {
 	panic("unreachable")
 }
 
+// scoreText returns the current score, ignoring any skipped questions. Partially correct principal parts questions
+// count for the fraction of parts that were correct.
+func (m *Model) scoreText() string {
+	return m.formatScore(func(_ float64, text string) string { return text })
+}
+
+// scoreView is the same as [Model.scoreText], but with the percentage coloured by how well the session is going.
+func (m *Model) scoreView() string {
+	return m.formatScore(func(percent float64, text string) string {
+		return m.styles.SessionPage.Score(percent).Render(text)
+	})
+}
+
+// formatScore formats the current score, using renderPercent to render the percentage.
+func (m *Model) formatScore(renderPercent func(percent float64, text string) string) string {
+	var text string
+	if m.answeredCount == 0 {
+		text = "Score: 0/0 (0.0%)"
+	} else {
+		percent := 100 * m.score / float64(m.answeredCount)
+		text = fmt.Sprintf(
+			"Score: %s/%d (%s)",
+			strconv.FormatFloat(math.Round(m.score*100)/100, 'f', -1, 64),
+			m.answeredCount,
+			renderPercent(percent, fmt.Sprintf("%.1f%%", percent)),
+		)
+	}
+
+	if m.skippedCount > 0 {
+		text += fmt.Sprintf(", %d skipped", m.skippedCount)
+	}
+
+	return text
+}
+
+// The minimum percentages needed for each grade. Anything below gradeD is an F.
+const (
+	gradeA = 90
+	gradeB = 80
+	gradeC = 70
+	gradeD = 60
+)
+
+// grade returns the letter grade for a percentage score.
+func grade(percent float64) string {
+	switch {
+	case percent >= gradeA:
+		return "A"
+
+	case percent >= gradeB:
+		return "B"
+
+	case percent >= gradeC:
+		return "C"
+
+	case percent >= gradeD:
+		return "D"
+
+	default:
+		return "F"
+	}
+}
+
+// progressView renders a progress bar showing how many of the questions have been reached.
+func (m *Model) progressView() string {
+	var percent float64
+	if total := m.questionProvider.Total(); total > 0 {
+		percent = float64(m.questionProvider.Current()) / float64(total)
+	}
+
+	m.progressBar.FullColor = m.styles.SessionPage.ProgressFull
+	m.progressBar.EmptyColor = m.styles.SessionPage.ProgressEmpty
+	m.progressBar.SetWidth(m.width - 2)
+
+	return m.progressBar.ViewAs(percent)
+}
+
+// elapsedText returns the time spent on the current question, which stops counting once it is answered. If
+// [Options.RevealAfter] is set, the time left to answer an unanswered question is returned instead.
+func (m *Model) elapsedText() string {
+	elapsed := m.questionElapsed
+	if m.currentQuestionModel.QuestionStatus() == questioncomponents.Unanswered {
+		elapsed = m.questionTime()
+
+		if m.options.RevealAfter > 0 {
+			left := max(m.options.RevealAfter-elapsed, 0)
+			return fmt.Sprintf("%ds left", int(math.Ceil(left.Seconds())))
+		}
+	}
+
+	return fmt.Sprintf("%ds", int(elapsed.Seconds()))
+}
+
+// questionModeNames are the names of each question mode, in the order they are shown in the score breakdown.
+var questionModeNames = []struct {
+	mode questions.QuestionMode
+	name string
+}{
+	{questions.Regular, "Regular"},
+	{questions.PrincipalParts, "Principal parts"},
+	{questions.MultipleChoice, "Multiple choice"},
+	{questions.ParseWord, "Parse word"},
+}
+
+// questionModeName returns the display name of mode.
+func questionModeName(mode questions.QuestionMode) string {
+	for _, mn := range questionModeNames {
+		if mn.mode == mode {
+			return mn.name
+		}
+	}
+
+	return "Unknown"
+}
+
+// scoreBreakdownView renders the score for each question mode that has been answered at least once.
+func (m *Model) scoreBreakdownView() string {
+	var lines []string
+
+	for _, mn := range questionModeNames {
+		score, ok := m.scoreByMode[mn.mode]
+		if !ok || score.total == 0 {
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf(
+			"%-16s %d/%d (%.0f%%)",
+			mn.name+":",
+			score.correct,
+			score.total,
+			100*float64(score.correct)/float64(score.total),
+		))
+	}
+
+	return m.styles.Faint.Render(strings.Join(lines, "\n"))
+}
+
+// formatAnswer formats the main answer of a question for display.
+func formatAnswer(answer any) string {
+	switch answer := answer.(type) {
+	case string:
+		return answer
+
+	case []string:
+		return strings.Join(answer, ", ")
+
+	case *pb.EndingComponents:
+		return answer.GetDisplayString()
+	}
+
+	return fmt.Sprint(answer)
+}
+
+// answeredQuestionView renders an answered question for review, under the given title.
+func (m *Model) answeredQuestionView(title string, q answeredQuestion) string {
+	responseStyle := m.styles.SessionPage.Incorrect
+	if q.correct {
+		responseStyle = m.styles.SessionPage.Correct
+	}
+
+	titleView := m.styles.Title.Render(title)
+	if q.hinted {
+		titleView += m.styles.Faint.Render(" (hint used)")
+	}
+
+	if q.gaveUp {
+		titleView += m.styles.Faint.Render(" (gave up)")
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		titleView,
+		m.styles.Bold.Render("Prompt: ")+m.styles.Italic.Render(q.prompt),
+		m.styles.Bold.Render("Your answer: ")+responseStyle.Render(q.response),
+		m.styles.Bold.Render("Correct answer: ")+m.styles.SessionPage.Correct.Render(q.answer),
+	)
+}
+
+// elapsedSessionText returns the time spent on the session so far, or in total once it is completed.
+func (m *Model) elapsedSessionText() string {
+	elapsed := m.sessionDuration
+	if m.appStatus != Completed {
+		elapsed = time.Since(m.sessionStart)
+	}
+
+	return elapsed.Round(time.Second).String()
+}
+
+// formatClock formats d as minutes and seconds, e.g. "03:07".
+func formatClock(d time.Duration) string {
+	seconds := int(d.Seconds())
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
+
+// Summary returns a plain text summary of the session, or an empty string if no session has been started.
+// This is intended to be printed after the TUI exits, so that the result of a session quit early is not lost.
+func (m *Model) Summary() string {
+	switch m.appStatus {
+	case Initialised:
+		return fmt.Sprintf("Session ended early after %s. %s", m.elapsedSessionText(), m.scoreText())
+
+	case Completed:
+		return fmt.Sprintf("Session completed in %s. %s", m.elapsedSessionText(), m.scoreText())
+	}
+
+	return ""
+}
+
 func (m *Model) View() string {
 	var content string
 	switch m.appStatus {
@@ -53,7 +267,26 @@ func (m *Model) View() string {
 			Render(content)
 
 	case Uninitialised:
-		content = "Loading..."
+		loadingText := "Contacting server..."
+		if m.options.QuestionsPath != "" {
+			loadingText = "Loading questions..."
+		}
+
+		m.loadingSpinner.Style = m.styles.Faint
+		content = m.loadingSpinner.View() + " " + loadingText
+
+		if cp := m.pendingCheckpoint; cp != nil {
+			content = lipgloss.JoinVertical(
+				lipgloss.Left,
+				m.styles.Bold.Render("Resume previous session? (y/n)"),
+				m.styles.Faint.Render(fmt.Sprintf(
+					"%d/%d questions done, %d answered correctly",
+					cp.QuestionsDone,
+					cp.Total,
+					countCorrect(cp.History),
+				)),
+			)
+		}
 
 		// probs doesn't matter
 		return m.styles.NormalBorder(false).
@@ -62,23 +295,61 @@ func (m *Model) View() string {
 			Render(content)
 
 	case Initialised:
+		if m.viewingHistory {
+			return m.styles.NormalBorder(true).
+				Width(m.width).
+				Height(m.height).
+				Render(m.answeredQuestionView(
+					fmt.Sprintf("Answered question %d/%d", m.historyIndex+1, len(m.history)),
+					m.history[m.historyIndex],
+				))
+		}
+
+		questionLabel := "Question"
+		if m.retrying {
+			questionLabel = "Retry question"
+		}
+
 		titleView := m.styles.Title.Render(
-			fmt.Sprintf("Question %d/%d", m.questionProvider.Current(), *m.numberOfQuestions),
+			fmt.Sprintf("%s %d/%d", questionLabel, m.questionProvider.Current(), m.questionProvider.Total()),
 		)
+		if !m.options.HideTimers {
+			titleView += m.styles.Faint.Render(" " + m.elapsedText())
+		}
+
+		if m.previousSkipped {
+			titleView += m.styles.Faint.Render(" (previous question skipped)")
+		}
+
+		if m.currentMarked() {
+			titleView += m.styles.Faint.Render(" ★ marked")
+		}
+
+		scoreView := m.scoreView()
+		if m.currentQuestionModel.QuestionStatus() != questioncomponents.Unanswered {
+			scoreView += fmt.Sprintf(" | Streak: %d", m.streak)
+		}
 
-		var footerView string
-		if m.answeredCount == 0 {
-			footerView = "Score: 0/0 (0%)"
-		} else {
-			footerView = fmt.Sprintf(
-				"Score: %d/%d (%.0f%%)",
-				m.correctCount,
-				m.answeredCount,
-				100*float64(m.correctCount)/float64(m.answeredCount),
+		footerView := lipgloss.JoinVertical(lipgloss.Left, m.progressView(), m.styles.Text.Render(scoreView))
+		if !m.options.HideTimers {
+			footerView = lipgloss.JoinVertical(
+				lipgloss.Left,
+				footerView,
+				m.styles.Faint.Render("Elapsed: "+formatClock(time.Since(m.sessionStart))),
 			)
 		}
 
-		footerView = m.styles.Text.Render(footerView)
+		if m.showHelpHint {
+			footerView = lipgloss.JoinVertical(
+				lipgloss.Left,
+				footerView,
+				m.styles.Faint.Render("(press ctrl+h for help)"),
+			)
+		}
+
+		if m.confirmingQuit {
+			footerView = m.styles.Bold.Render("Quit session? Progress will be lost. (y/n)")
+		}
 
 		m.currentQuestionModel.SetWidth(m.width - 2)
 		m.currentQuestionModel.SetHeight(
@@ -88,20 +359,64 @@ func (m *Model) View() string {
 
 		content = lipgloss.JoinVertical(lipgloss.Left, titleView, inputView, footerView)
 
-		return m.styles.NormalBorder(m.currentQuestionModel.Focused()).
+		borderStyle := m.styles.NormalBorder(m.currentQuestionModel.Focused())
+		if m.flashing {
+			borderStyle = borderStyle.BorderForeground(m.styles.SessionPage.Incorrect.GetForeground())
+		}
+
+		return borderStyle.
 			Width(m.width).
 			Height(m.height).
 			Render(content)
 
 	case Completed:
+		missed := m.missedQuestions()
+
+		if m.reviewing {
+			return m.styles.NormalBorder(true).
+				Width(m.width).
+				Height(m.height).
+				Render(m.answeredQuestionView(
+					fmt.Sprintf("Mistake %d/%d", m.reviewIndex+1, len(missed)),
+					missed[m.reviewIndex],
+				))
+		}
+
 		messageView := "Session completed!"
+		if m.retrying {
+			messageView = fmt.Sprintf(
+				"Retry completed! First attempt: %d/%d",
+				m.firstRoundCorrect,
+				m.firstRoundAnswered,
+			)
+		}
 
-		scoreView := fmt.Sprintf(
-			"Score: %d/%d (%.0f%%)",
-			m.correctCount,
-			m.answeredCount,
-			100*float64(m.correctCount)/float64(m.answeredCount),
-		)
+		scoreView := m.scoreView()
+		if m.answeredCount > 0 {
+			scoreView += " | Grade: " + grade(100*m.score/float64(m.answeredCount))
+		}
+
+		scoreView += fmt.Sprintf(" | Best streak: %d", m.bestStreak)
+		if m.hintsUsed > 0 {
+			scoreView += fmt.Sprintf(" | Hints used: %d", m.hintsUsed)
+		}
+
+		if m.gaveUpCount > 0 {
+			scoreView += fmt.Sprintf(" | Gave up: %d", m.gaveUpCount)
+		}
+
+		durationView := "Time taken: " + m.elapsedSessionText()
+		if m.questionsRequested > 0 && !m.retrying {
+			durationView = lipgloss.JoinVertical(
+				lipgloss.Left,
+				durationView,
+				m.styles.Faint.Render(fmt.Sprintf(
+					"Requested %d questions, but only %d were available",
+					m.questionsRequested,
+					m.questionProvider.Total(),
+				)),
+			)
+		}
 
 		returnButtonView := m.styles.Button(true, m.returnButton.Focused()).
 			MarginRight(2).
@@ -109,13 +424,37 @@ func (m *Model) View() string {
 		restartButtonView := m.styles.Button(true, m.restartButton.Focused()).Render("Try again")
 		buttonView := lipgloss.JoinHorizontal(lipgloss.Top, returnButtonView, restartButtonView)
 
-		content = lipgloss.JoinVertical(lipgloss.Left, messageView, scoreView, buttonView)
+		if len(missed) > 0 {
+			reviewButtonView := m.styles.Button(true, m.reviewButton.Focused()).
+				MarginLeft(2).
+				Render("Review mistakes")
+			buttonView = lipgloss.JoinHorizontal(lipgloss.Top, buttonView, reviewButtonView)
+		}
+
+		var retryView string
+		if m.offerRetry() {
+			retryView = m.styles.Bold.Render(
+				fmt.Sprintf("Retry %d incorrect questions? (y/n)", len(m.retryableQuestions())),
+			)
+		}
 
-		return m.styles.NormalBorder(m.returnButton.Focused() || m.restartButton.Focused()).
+		content = lipgloss.JoinVertical(
+			lipgloss.Left,
+			messageView,
+			scoreView,
+			m.scoreBreakdownView(),
+			durationView,
+			retryView,
+			buttonView,
+		)
+
+		buttonFocused := m.returnButton.Focused() || m.restartButton.Focused() || m.reviewButton.Focused()
+
+		return m.styles.NormalBorder(buttonFocused).
 			Width(m.width).
 			Height(m.height).
 			Render(content)
 	}
 
-	panic("unreachable")
+	panic("unknown session status: " + m.appStatus.String())
 }
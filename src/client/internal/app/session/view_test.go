@@ -0,0 +1,166 @@
+package session
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"charm.land/bubbles/v2/progress"
+	"charm.land/bubbles/v2/spinner"
+	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/exp/teatest/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/create"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/app/session/questions"
+	"github.com/rduo1009/vocab-tuister/src/client/internal/styles"
+)
+
+func TestLetterGrade(t *testing.T) {
+	tests := []struct {
+		percent float64
+		want    string
+	}{
+		{percent: 100, want: "A"},
+		{percent: 90, want: "A"},
+		{percent: 89.9, want: "B"},
+		{percent: 80, want: "B"},
+		{percent: 79.9, want: "C"},
+		{percent: 70, want: "C"},
+		{percent: 69.9, want: "D"},
+		{percent: 60, want: "D"},
+		{percent: 59.9, want: "F"},
+		{percent: 0, want: "F"},
+	}
+
+	for _, tt := range tests {
+		assert.Equalf(t, tt.want, grade(tt.percent), "wrong grade for %.1f%%", tt.percent)
+	}
+}
+
+func TestViewTimers(t *testing.T) {
+	tests := map[string]struct {
+		hideTimers bool
+		want       []string
+		notWant    []string
+	}{
+		"Hidden":  {hideTimers: true, notWant: []string{"Elapsed:", "7s"}},
+		"Visible": {hideTimers: false, want: []string{"Elapsed: 01:05", "7s"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newTestSession(t, Options{}, questions.Questions{typeIn("puer", "boy")})
+			m.options.HideTimers = tt.hideTimers
+			m.sessionStart = time.Now().Add(-65 * time.Second)
+			m.questionStart = time.Now().Add(-7 * time.Second)
+
+			view := m.View()
+
+			for _, s := range tt.want {
+				assert.Contains(t, view, s)
+			}
+
+			for _, s := range tt.notWant {
+				assert.NotContains(t, view, s)
+			}
+		})
+	}
+}
+
+func TestProgressBar(t *testing.T) {
+	tests := map[string]struct {
+		answers     []string
+		wantPrompt  string
+		wantCurrent int
+		wantFilled  int
+	}{
+		// the bar is 98 cells wide, and shows the current question out of the total
+		"First":  {wantPrompt: "puer", wantCurrent: 1, wantFilled: 25},
+		"Second": {answers: []string{"boy"}, wantPrompt: "puella", wantCurrent: 2, wantFilled: 49},
+		"Third":  {answers: []string{"boy", "dog"}, wantPrompt: "servus", wantCurrent: 3, wantFilled: 74},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			qs := questions.Questions{
+				typeIn("puer", "boy"),
+				typeIn("puella", "girl"),
+				typeIn("servus", "slave"),
+				typeIn("dominus", "master"),
+			}
+
+			m := runSession(t, newTestSession(t, Options{}, qs), func(tm *teatest.TestModel) {
+				for _, response := range tt.answers {
+					answerTypeIn(tm, response)
+				}
+
+				waitForOutput(t, tm, tt.wantPrompt)
+			})
+
+			require.Equal(t, tt.wantCurrent, m.questionProvider.Current())
+
+			bar := m.progressView()
+			assert.Equal(t, tt.wantFilled, strings.Count(bar, string(progress.DefaultFullCharHalfBlock)))
+			assert.Equal(t, 98-tt.wantFilled, strings.Count(bar, string(progress.DefaultEmptyCharBlock)))
+		})
+	}
+}
+
+func TestHelpHint(t *testing.T) {
+	const hint = "(press ctrl+h for help)"
+
+	qs := questions.Questions{typeIn("puer", "boy")}
+	m := newTestSession(t, Options{}, qs)
+	assert.Contains(t, m.View(), hint)
+
+	// the first key press hides it, even before the question is answered
+	m.Update(tea.KeyPressMsg{Code: 'b', Text: "b"})
+	assert.NotContains(t, m.View(), hint)
+
+	// and it isn't shown again when the session is restarted
+	m.reset()
+	m.Update(QuestionStreamGetMsg{QuestionProvider: &SliceQuestionProvider{questions: qs}, reordered: true})
+	require.Equal(t, Initialised, m.appStatus)
+	assert.NotContains(t, m.View(), hint)
+}
+
+func TestLoadingSpinner(t *testing.T) {
+	tests := map[string]struct {
+		static    bool
+		wantTicks bool
+	}{
+		"Still":    {static: true},
+		"Animated": {static: false, wantTicks: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := styles.StylesWrapper{Styles: styles.DefaultStyles(styles.DefaultThemes(true).Current(), false)}
+			status := create.StatusVerified
+			marking := questions.MarkingOptions{}
+			options := Options{QuestionsPath: filepath.Join(t.TempDir(), "questions.json"), StaticSpinner: tt.static}
+
+			m := New(&status, &status, app.ServerOptions{}, options, nil, nil, nil, &marking, &s)
+			_, cmd := m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+			require.Equal(t, Uninitialised, m.appStatus)
+			assert.Contains(t, m.View(), spinner.Dot.Frames[0])
+			assert.Contains(t, m.View(), "Loading questions...")
+
+			batch, ok := cmd().(tea.BatchMsg)
+			require.True(t, ok)
+
+			var ticks bool
+			for _, c := range batch {
+				if _, ok := c().(spinner.TickMsg); ok {
+					ticks = true
+				}
+			}
+
+			assert.Equal(t, tt.wantTicks, ticks, "spinner ticked")
+		})
+	}
+}
@@ -36,3 +36,10 @@ type PageModel interface {
 	HasOverlay() bool
 	OverlayView(width, height int) (view string, x, y int)
 }
+
+// QuitConfirmer is implemented by pages which need the user to confirm before quitting, e.g. to avoid losing progress.
+type QuitConfirmer interface {
+	// ConfirmQuit starts asking the user to confirm that they want to quit, and reports whether it did so. If it
+	// returns false, the TUI can quit straight away.
+	ConfirmQuit() bool
+}
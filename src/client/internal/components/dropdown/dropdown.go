@@ -62,6 +62,11 @@ func (m *Model) KeyMap() help.KeyMap {
 	return m.list
 }
 
+// CursorKeys returns the bindings for moving up and down the items, so that their keys can be changed.
+func (m *Model) CursorKeys() (up, down *key.Binding) {
+	return &m.list.KeyMap.CursorUp, &m.list.KeyMap.CursorDown
+}
+
 func New[T fmt.Stringer](id string, items []T, styles *styles.StylesWrapper) *Model {
 	var (
 		listItems []list.Item
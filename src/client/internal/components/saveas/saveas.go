@@ -121,6 +121,11 @@ func (m *Model) Init() tea.Cmd {
 	return tea.Batch(m.filepicker.Init(), textinput.Blink)
 }
 
+// SetFilename pre-fills the filename that will be saved to.
+func (m *Model) SetFilename(filename string) {
+	m.textinput.SetValue(filename)
+}
+
 func (m *Model) RefreshFilepickerDir() tea.Cmd {
 	return m.filepicker.Init()
 }
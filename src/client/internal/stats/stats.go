@@ -0,0 +1,158 @@
+// Package stats reads the results files saved at the end of sessions, and summarises them.
+package stats
+
+import (
+	"encoding/json/v2"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// QuestionResult is the record of a single answered question, as saved to a results file.
+type QuestionResult struct {
+	Prompt       string `json:"prompt"`
+	MainAnswer   string `json:"main-answer"`
+	Response     string `json:"response"`
+	Correct      bool   `json:"correct"`
+	Hinted       bool   `json:"hinted"`
+	GaveUp       bool   `json:"gave-up"`
+	QuestionMode string `json:"question-mode"`
+}
+
+// Results is the summary of a completed session, as saved to a results file.
+type Results struct {
+	Score      float64          `json:"score"` // including partial credit for principal parts questions
+	Answered   int              `json:"answered"`
+	Correct    int              `json:"correct"`
+	Skipped    int              `json:"skipped"`
+	DurationMs int64            `json:"duration-ms"`
+	Questions  []QuestionResult `json:"questions"`
+}
+
+// ReadResults reads a results file.
+func ReadResults(filePath string) (*Results, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session results from %s: %w", filePath, err)
+	}
+
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("failed to parse session results from %s: %w", filePath, err)
+	}
+
+	return &results, nil
+}
+
+// ModeSummary is the number of questions of a single question mode answered correctly, out of those answered.
+type ModeSummary struct {
+	Correct, Answered int
+}
+
+// Summary is the aggregate of the results of several sessions.
+type Summary struct {
+	Sessions      int
+	Correct       int
+	Answered      int
+	TotalDuration time.Duration
+	ByMode        map[string]ModeSummary // keyed by the display name of the question mode
+}
+
+// Accuracy returns the percentage of answered questions that were answered correctly.
+func (s *Summary) Accuracy() float64 {
+	if s.Answered == 0 {
+		return 0
+	}
+
+	return 100 * float64(s.Correct) / float64(s.Answered)
+}
+
+// AverageDuration returns the average time taken to complete a session.
+func (s *Summary) AverageDuration() time.Duration {
+	if s.Sessions == 0 {
+		return 0
+	}
+
+	return s.TotalDuration / time.Duration(s.Sessions)
+}
+
+// Add adds the results of a session to the summary.
+func (s *Summary) Add(results *Results) {
+	if s.ByMode == nil {
+		s.ByMode = make(map[string]ModeSummary)
+	}
+
+	s.Sessions++
+	s.Correct += results.Correct
+	s.Answered += results.Answered
+	s.TotalDuration += time.Duration(results.DurationMs) * time.Millisecond
+
+	for _, q := range results.Questions {
+		mode := s.ByMode[q.QuestionMode]
+		mode.Answered++
+
+		if q.Correct {
+			mode.Correct++
+		}
+
+		s.ByMode[q.QuestionMode] = mode
+	}
+}
+
+// Summarise reads the results files at the given paths, which may also be directories of results files, and
+// summarises them. Files that can't be read are skipped, and an error is returned for each of them.
+func Summarise(paths []string) (*Summary, []error) {
+	var (
+		summary Summary
+		errs    []error
+	)
+
+	for _, path := range paths {
+		files, err := resultsFiles(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, file := range files {
+			results, err := ReadResults(file)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			summary.Add(results)
+		}
+	}
+
+	return &summary, errs
+}
+
+// resultsFiles returns the JSON files in path if it is a directory, or otherwise just path itself.
+func resultsFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session results from %s: %w", path, err)
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session results from directory %s: %w", path, err)
+	}
+
+	var files []string
+
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.EqualFold(filepath.Ext(entry.Name()), ".json") {
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,92 @@
+package stats
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadResults(t *testing.T) {
+	results, err := ReadResults(filepath.Join("testdata", "results", "first.json"))
+	require.NoError(t, err)
+
+	assert.InDelta(t, 2.0, results.Score, 0)
+	assert.Equal(t, 3, results.Answered)
+	assert.Equal(t, 2, results.Correct)
+	assert.Equal(t, 1, results.Skipped)
+	assert.Equal(t, int64(60000), results.DurationMs)
+	require.Len(t, results.Questions, 3)
+	assert.Equal(t, QuestionResult{
+		Prompt:       "puer",
+		MainAnswer:   "boy",
+		Response:     "boy",
+		Correct:      true,
+		QuestionMode: "Regular",
+	}, results.Questions[0])
+}
+
+func TestSummarise(t *testing.T) {
+	summary, errs := Summarise([]string{filepath.Join("testdata", "results")})
+	require.Empty(t, errs)
+
+	assert.Equal(t, 2, summary.Sessions)
+	assert.Equal(t, 3, summary.Correct)
+	assert.Equal(t, 5, summary.Answered)
+	assert.Equal(t, 3*time.Minute, summary.TotalDuration)
+	assert.Equal(t, 90*time.Second, summary.AverageDuration())
+	assert.InDelta(t, 60.0, summary.Accuracy(), 1e-9)
+	assert.Equal(t, map[string]ModeSummary{
+		"Regular":         {Correct: 1, Answered: 3},
+		"Multiple choice": {Correct: 1, Answered: 1},
+		"Principal parts": {Correct: 1, Answered: 1},
+	}, summary.ByMode)
+}
+
+func TestSummariseFiles(t *testing.T) {
+	summary, errs := Summarise([]string{
+		filepath.Join("testdata", "results", "first.json"),
+		filepath.Join("testdata", "results", "first.json"),
+	})
+	require.Empty(t, errs)
+
+	// the same file given twice is counted twice
+	assert.Equal(t, 2, summary.Sessions)
+	assert.Equal(t, 6, summary.Answered)
+}
+
+func TestSummariseSkipsUnreadable(t *testing.T) {
+	tests := map[string]struct {
+		path    string
+		wantErr string
+	}{
+		"Missing":     {path: filepath.Join("testdata", "missing.json"), wantErr: "failed to read session results"},
+		"CorruptDir":  {path: filepath.Join("testdata", "corrupt"), wantErr: "failed to parse session results"},
+		"CorruptFile": {path: filepath.Join("testdata", "corrupt", "truncated.json"), wantErr: "truncated.json"},
+		"NotResults":  {path: filepath.Join("testdata", "results", "notes.txt"), wantErr: "notes.txt"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			summary, errs := Summarise([]string{tt.path, filepath.Join("testdata", "results")})
+
+			require.Len(t, errs, 1)
+			assert.ErrorContains(t, errs[0], tt.wantErr)
+
+			// the readable results are still summarised
+			assert.Equal(t, 2, summary.Sessions)
+			assert.Equal(t, 5, summary.Answered)
+		})
+	}
+}
+
+func TestSummaryEmpty(t *testing.T) {
+	summary, errs := Summarise(nil)
+	require.Empty(t, errs)
+
+	assert.Zero(t, summary.Sessions)
+	assert.Zero(t, summary.Accuracy())
+	assert.Zero(t, summary.AverageDuration())
+}
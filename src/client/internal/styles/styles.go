@@ -19,12 +19,13 @@ import (
 type StylesWrapper struct{ Styles }
 
 type Styles struct {
-	Text   lipgloss.Style
-	Title  lipgloss.Style
-	Bold   lipgloss.Style
-	Italic lipgloss.Style
-	Faint  lipgloss.Style
-	Error  lipgloss.Style // red text without bolding or italics
+	Text    lipgloss.Style
+	Title   lipgloss.Style
+	Bold    lipgloss.Style
+	Italic  lipgloss.Style
+	Faint   lipgloss.Style
+	Error   lipgloss.Style // red text without bolding or italics
+	Warning lipgloss.Style // yellow text without bolding or italics
 
 	Overlay struct {
 		Title  lipgloss.Style
@@ -50,8 +51,11 @@ type Styles struct {
 	}
 
 	SessionPage struct {
-		Correct   lipgloss.Style
-		Incorrect lipgloss.Style
+		Correct       lipgloss.Style
+		Incorrect     lipgloss.Style
+		ProgressFull  color.Color
+		ProgressEmpty color.Color
+		Score         func(percent float64) lipgloss.Style // coloured by how well the session is going
 	}
 
 	MultipleChoice struct {
@@ -107,6 +111,7 @@ func DefaultStyles(theme *tint.Tint, overlayActive bool) Styles {
 	s.Italic = lipgloss.NewStyle().Italic(true).Foreground(overlayDim(colours.Fg))
 	s.Faint = lipgloss.NewStyle().Faint(true).Foreground(overlayDim(colours.Fg))
 	s.Error = lipgloss.NewStyle().Foreground(overlayDim(colours.Red))
+	s.Warning = lipgloss.NewStyle().Foreground(overlayDim(colours.Yellow))
 
 	s.Overlay.Title = lipgloss.NewStyle().Bold(true).Underline(true)
 	s.Overlay.Bold = lipgloss.NewStyle().Bold(true)
@@ -276,6 +281,20 @@ func DefaultStyles(theme *tint.Tint, overlayActive bool) Styles {
 
 	s.SessionPage.Correct = lipgloss.NewStyle().Bold(true).Foreground(colours.Green)
 	s.SessionPage.Incorrect = lipgloss.NewStyle().Bold(true).Foreground(colours.Red)
+	s.SessionPage.ProgressFull = overlayDim(colours.Blue)
+	s.SessionPage.ProgressEmpty = overlayDim(blend(colours.Fg, colours.Bg, 0.8))
+	s.SessionPage.Score = func(percent float64) lipgloss.Style {
+		switch {
+		case percent >= 80:
+			return lipgloss.NewStyle().Foreground(overlayDim(colours.Green))
+
+		case percent >= 50:
+			return lipgloss.NewStyle().Foreground(overlayDim(colours.Yellow))
+
+		default:
+			return lipgloss.NewStyle().Foreground(overlayDim(colours.Red))
+		}
+	}
 
 	s.MultipleChoice.Option = func(focused bool, color color.Color) lipgloss.Style {
 		borderColor := color
@@ -0,0 +1,41 @@
+package styles
+
+import (
+	"image/color"
+	"testing"
+
+	"charm.land/lipgloss/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionPageScore(t *testing.T) {
+	theme := DefaultThemes(true).Current()
+	colours := DefaultColours(theme)
+
+	tests := []struct {
+		percent       float64
+		overlayActive bool
+		want          color.Color
+	}{
+		{percent: 100, want: colours.Green},
+		{percent: 80, want: colours.Green},
+		{percent: 79.9, want: colours.Yellow},
+		{percent: 50, want: colours.Yellow},
+		{percent: 49.9, want: colours.Red},
+		{percent: 0, want: colours.Red},
+		{percent: 80, overlayActive: true, want: lipgloss.Darken(colours.Green, 0.4)},
+		{percent: 49.9, overlayActive: true, want: lipgloss.Darken(colours.Red, 0.4)},
+	}
+
+	for _, tt := range tests {
+		s := DefaultStyles(theme, tt.overlayActive)
+		assert.Equalf(
+			t,
+			tt.want,
+			s.SessionPage.Score(tt.percent).GetForeground(),
+			"wrong colour for %.1f%% (overlay active: %t)",
+			tt.percent,
+			tt.overlayActive,
+		)
+	}
+}
@@ -0,0 +1,123 @@
+// Package flatyaml reads and writes YAML documents made up of a single mapping of keys to scalar values, such as
+// session configs. Anything more complex (nested mappings, sequences, anchors, etc.) is not supported.
+package flatyaml
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Marshal returns the YAML encoding of values, with the keys in sorted order. The values must be booleans, numbers or
+// strings.
+func Marshal(values map[string]any) ([]byte, error) {
+	var sb strings.Builder
+
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		var value string
+
+		switch v := values[key].(type) {
+		case bool:
+			value = strconv.FormatBool(v)
+
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+
+		case int:
+			value = strconv.Itoa(v)
+
+		case string:
+			value = strconv.Quote(v)
+
+		default:
+			return nil, fmt.Errorf("cannot marshal %s: unsupported type %T", key, v)
+		}
+
+		sb.WriteString(key + ": " + value + "\n")
+	}
+
+	return []byte(sb.String()), nil
+}
+
+// Unmarshal parses a YAML document made up of a single mapping of keys to scalar values. Booleans are returned as
+// bool, numbers as float64 (like encoding/json) and everything else as string.
+func Unmarshal(data []byte) (map[string]any, error) {
+	values := make(map[string]any)
+
+	for i, line := range strings.Split(string(data), "\n") {
+		lineNumber := i + 1
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+
+		if strings.TrimLeft(line, " \t") != line {
+			return nil, fmt.Errorf("line %d: nested values are not supported", lineNumber)
+		}
+
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("line %d: expected a line of the form \"key: value\"", lineNumber)
+		}
+
+		key = strings.TrimSpace(key)
+		if _, seen := values[key]; seen {
+			return nil, fmt.Errorf("line %d: duplicate key %q", lineNumber, key)
+		}
+
+		parsed, err := parseScalar(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		values[key] = parsed
+	}
+
+	return values, nil
+}
+
+// parseScalar parses the value of a key, which may be a boolean, a number or a (possibly quoted) string. A comment
+// after an unquoted value is ignored.
+func parseScalar(value string) (any, error) {
+	if !strings.HasPrefix(value, `"`) && !strings.HasPrefix(value, "'") {
+		value, _, _ = strings.Cut(value, " #")
+		value = strings.TrimSpace(value)
+	}
+
+	switch {
+	case value == "":
+		return nil, errors.New("missing value")
+
+	case value == "true":
+		return true, nil
+
+	case value == "false":
+		return false, nil
+
+	case strings.HasPrefix(value, `"`):
+		s, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quoted string %s", value)
+		}
+
+		return s, nil
+
+	case strings.HasPrefix(value, "'"):
+		s, ok := strings.CutSuffix(value[1:], "'")
+		if !ok {
+			return nil, fmt.Errorf("invalid quoted string %s", value)
+		}
+
+		return strings.ReplaceAll(s, "''", "'"), nil
+	}
+
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		return n, nil
+	}
+
+	return value, nil
+}
@@ -0,0 +1,85 @@
+package flatyaml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rduo1009/vocab-tuister/src/client/internal/util/flatyaml"
+)
+
+func TestMarshal(t *testing.T) {
+	got, err := flatyaml.Marshal(map[string]any{
+		"number-of-questions":     50.0,
+		"exclude-verbs":           false,
+		"include-typein-engtolat": true,
+		"name":                    "it's a \"test\"",
+	})
+	require.NoError(t, err)
+
+	want := `exclude-verbs: false
+include-typein-engtolat: true
+name: "it's a \"test\""
+number-of-questions: 50
+`
+	assert.Equal(t, want, string(got))
+}
+
+func TestMarshalUnsupported(t *testing.T) {
+	_, err := flatyaml.Marshal(map[string]any{"nested": map[string]any{"a": true}})
+	assert.Error(t, err)
+}
+
+func TestRoundTrip(t *testing.T) {
+	values := map[string]any{
+		"number-of-questions":           50.0,
+		"number-multiplechoice-options": 3.0,
+		"exclude-verbs":                 false,
+		"exclude-nouns":                 true,
+		"require-macrons":               true,
+		"name":                          "session: #1",
+	}
+
+	data, err := flatyaml.Marshal(values)
+	require.NoError(t, err)
+
+	got, err := flatyaml.Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, values, got)
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		want    map[string]any
+		wantErr bool
+	}{
+		"Scalars": {
+			input: "a: true\nb: 1.5\nc: plain text\nd: 'single ''quoted'''\n",
+			want:  map[string]any{"a": true, "b": 1.5, "c": "plain text", "d": "single 'quoted'"},
+		},
+		"CommentsAndBlankLines": {
+			input: "---\n# a comment\n\na: false # trailing comment\n",
+			want:  map[string]any{"a": false},
+		},
+		"Empty":          {input: "", want: map[string]any{}},
+		"Nested":         {input: "a:\n  b: true\n", wantErr: true},
+		"MissingColon":   {input: "a true\n", wantErr: true},
+		"DuplicateKey":   {input: "a: true\na: false\n", wantErr: true},
+		"BadQuotedValue": {input: "a: \"unterminated\n", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := flatyaml.Unmarshal([]byte(tt.input))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}